@@ -0,0 +1,39 @@
+#!/bin/bash
+#
+# Copyright (c) 2020, Oracle and/or its affiliates.
+# Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+#
+# Create a self-signed certificate for the operator webhook, store it in the secret mounted by
+# deploy/operator.yaml and set the CA bundle of the HelidonApp CRD conversion webhook and of the
+# HelidonApp validating webhook. The CRD and deploy/webhook_configuration.yaml must already be installed.
+set -o errexit
+set -o nounset
+set -o pipefail
+
+NAMESPACE=${1:-default}
+SERVICE=helidon-app-webhook
+SECRET=helidon-app-webhook-cert
+CRD=helidonapps.verrazzano.io
+VALIDATING_WEBHOOK=helidon-app-validation
+
+CERT_DIR=$(mktemp -d)
+trap "rm -rf ${CERT_DIR}" EXIT
+
+openssl req -x509 -newkey rsa:2048 -nodes -days 365 \
+  -keyout ${CERT_DIR}/tls.key -out ${CERT_DIR}/tls.crt \
+  -subj "/CN=${SERVICE}.${NAMESPACE}.svc" \
+  -addext "subjectAltName=DNS:${SERVICE}.${NAMESPACE}.svc,DNS:${SERVICE}.${NAMESPACE}.svc.cluster.local"
+
+kubectl -n ${NAMESPACE} create secret tls ${SECRET} \
+  --cert=${CERT_DIR}/tls.crt --key=${CERT_DIR}/tls.key \
+  --dry-run=client -o yaml | kubectl apply -f -
+
+CA_BUNDLE=$(base64 < ${CERT_DIR}/tls.crt | tr -d '\n')
+kubectl patch crd ${CRD} --type=json -p "[
+  {\"op\": \"add\", \"path\": \"/spec/conversion/webhook/clientConfig/caBundle\", \"value\": \"${CA_BUNDLE}\"},
+  {\"op\": \"replace\", \"path\": \"/spec/conversion/webhook/clientConfig/service/namespace\", \"value\": \"${NAMESPACE}\"}
+]"
+kubectl patch validatingwebhookconfiguration ${VALIDATING_WEBHOOK} --type=json -p "[
+  {\"op\": \"add\", \"path\": \"/webhooks/0/clientConfig/caBundle\", \"value\": \"${CA_BUNDLE}\"},
+  {\"op\": \"replace\", \"path\": \"/webhooks/0/clientConfig/service/namespace\", \"value\": \"${NAMESPACE}\"}
+]"
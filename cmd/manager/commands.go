@@ -0,0 +1,74 @@
+// Copyright (c) 2020, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	verrazzanov1 "github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1"
+	"github.com/verrazzano/verrazzano-helidon-app-operator/pkg/render"
+)
+
+// command is a subcommand of the manager binary that runs instead of the operator
+type command func(args []string, stdin io.Reader, stdout io.Writer, stderr io.Writer) error
+
+// commands are the subcommands of the manager binary, selected by the first argument
+var commands = map[string]command{
+	"render": runRender,
+	"import": runImport,
+	"graph":  runGraph,
+}
+
+// fileList is a flag that can be repeated to give several files
+type fileList []string
+
+func (f *fileList) String() string {
+	return strings.Join(*f, ",")
+}
+
+func (f *fileList) Set(value string) error {
+	*f = append(*f, value)
+	return nil
+}
+
+// readFiles calls read with the content of each of the files, or of stdin for "-". Each file is closed once
+// read.
+func readFiles(files []string, stdin io.Reader, read func(file string, in io.Reader) error) error {
+	for _, file := range files {
+		if err := readFile(file, stdin, read); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// readFile calls read with the content of the file, or of stdin for "-"
+func readFile(file string, stdin io.Reader, read func(file string, in io.Reader) error) error {
+	if file == "-" {
+		return read(file, stdin)
+	}
+	f, err := os.Open(file)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	return read(file, f)
+}
+
+// readHelidonApps reads the HelidonApps from the files, or from stdin for "-"
+func readHelidonApps(files []string, stdin io.Reader) ([]*verrazzanov1.HelidonApp, error) {
+	var apps []*verrazzanov1.HelidonApp
+	err := readFiles(files, stdin, func(file string, in io.Reader) error {
+		read, err := render.ReadHelidonApps(in)
+		if err != nil {
+			return fmt.Errorf("failed to read HelidonApps from %s: %s", file, err.Error())
+		}
+		apps = append(apps, read...)
+		return nil
+	})
+	return apps, err
+}
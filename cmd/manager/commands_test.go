@@ -0,0 +1,37 @@
+// Copyright (c) 2020, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// Test reading HelidonApps from files and stdin, in the order they are given
+func TestReadHelidonApps(t *testing.T) {
+	dir, err := ioutil.TempDir("", "commands")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+	file := filepath.Join(dir, "app.yaml")
+	assert.NoError(t, ioutil.WriteFile(file, []byte(strings.Replace(renderTestApp, "name: hello\n  namespace", "name: other\n  namespace", 1)), 0600))
+
+	apps, err := readHelidonApps([]string{file, "-"}, strings.NewReader(renderTestApp))
+	assert.NoError(t, err)
+	if assert.Len(t, apps, 2) {
+		assert.Equal(t, "other", apps[0].Name)
+		assert.Equal(t, "hello", apps[1].Name)
+	}
+
+	_, err = readHelidonApps([]string{file, filepath.Join(dir, "missing.yaml")}, nil)
+	assert.Error(t, err)
+	_, err = readHelidonApps([]string{"-"}, strings.NewReader("kind: HelidonApp\nspec: [\n"))
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "failed to read HelidonApps from -")
+	}
+}
@@ -0,0 +1,104 @@
+// Copyright (c) 2020, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis"
+	verrazzanov1 "github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1"
+	"github.com/verrazzano/verrazzano-helidon-app-operator/pkg/dependencies"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/config"
+)
+
+// runGraph prints the spec.dependsOn graph of the HelidonApps read from files or from the cluster, in the DOT
+// format of Graphviz
+func runGraph(args []string, stdin io.Reader, stdout io.Writer, stderr io.Writer) error {
+	flags := flag.NewFlagSet("graph", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	var files fileList
+	flags.Var(&files, "f", "file holding HelidonApps, - for stdin. Can be repeated. Reads from the cluster if not set")
+	namespace := flags.String("namespace", "", "namespace of the HelidonApps read from the cluster, all namespaces if not set. "+
+		"HelidonApps read from files without one are put in this namespace, or in default")
+	flags.Usage = func() {
+		fmt.Fprintf(flags.Output(), "Usage: %s graph [-f FILE]... [-namespace NAMESPACE]\n", os.Args[0])
+		fmt.Fprintln(flags.Output(), "Prints the dependencies between HelidonApps in the DOT format, render it with: dot -Tsvg")
+		flags.PrintDefaults()
+	}
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	var apps []verrazzanov1.HelidonApp
+	var err error
+	if len(files) > 0 {
+		apps, err = readGraphFiles(files, *namespace, stdin)
+	} else {
+		apps, err = readGraphCluster(*namespace)
+	}
+	if err != nil {
+		return err
+	}
+	// Each cycle is reported once, from the first of its HelidonApps
+	graph := dependencies.NewGraph(apps)
+	inCycle := make(map[types.NamespacedName]bool)
+	for _, app := range apps {
+		key := types.NamespacedName{Namespace: app.Namespace, Name: app.Name}
+		if cycle := graph.Cycle(key); cycle != nil && cycle[0] == key && !inCycle[key] {
+			for _, k := range cycle {
+				inCycle[k] = true
+			}
+			fmt.Fprintf(stderr, "Warning: dependency cycle %s\n", dependencies.FormatCycle(cycle))
+		}
+	}
+	return graph.WriteDOT(stdout)
+}
+
+// readGraphFiles reads the HelidonApps from the files, HelidonApps without a namespace are put in the given
+// namespace or in default
+func readGraphFiles(files []string, namespace string, stdin io.Reader) ([]verrazzanov1.HelidonApp, error) {
+	if namespace == "" {
+		namespace = "default"
+	}
+	read, err := readHelidonApps(files, stdin)
+	if err != nil {
+		return nil, err
+	}
+	apps := make([]verrazzanov1.HelidonApp, len(read))
+	for i, app := range read {
+		if app.Namespace == "" {
+			app.Namespace = namespace
+		}
+		apps[i] = *app
+	}
+	return apps, nil
+}
+
+// readGraphCluster lists the HelidonApps of the namespace, or of all namespaces, from the cluster
+func readGraphCluster(namespace string) ([]verrazzanov1.HelidonApp, error) {
+	cfg, err := config.GetConfig()
+	if err != nil {
+		return nil, err
+	}
+	s := runtime.NewScheme()
+	if err := apis.AddToScheme(s); err != nil {
+		return nil, err
+	}
+	c, err := client.New(cfg, client.Options{Scheme: s})
+	if err != nil {
+		return nil, err
+	}
+	apps := &verrazzanov1.HelidonAppList{}
+	if err := c.List(context.TODO(), apps, client.InNamespace(namespace)); err != nil {
+		return nil, err
+	}
+	return apps.Items, nil
+}
@@ -0,0 +1,56 @@
+// Copyright (c) 2020, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const graphTestApps = `
+apiVersion: verrazzano.io/v1
+kind: HelidonApp
+metadata:
+  name: orders
+spec:
+  name: orders
+  namespace: orders
+  container:
+    image: orders:1.0
+  dependsOn:
+  - name: payments
+---
+apiVersion: verrazzano.io/v1
+kind: HelidonApp
+metadata:
+  name: payments
+spec:
+  name: payments
+  namespace: payments
+  container:
+    image: payments:1.0
+  dependsOn:
+  - name: orders
+`
+
+// Test printing the dependency graph of HelidonApps read from stdin, with a warning for the cycle
+func TestRunGraph(t *testing.T) {
+	out := &bytes.Buffer{}
+	errOut := &bytes.Buffer{}
+	assert.NoError(t, runGraph([]string{"-f", "-", "-namespace", "shop"}, strings.NewReader(graphTestApps), out, errOut))
+	assert.Equal(t, `digraph dependencies {
+  "shop/orders";
+  "shop/payments";
+  "shop/orders" -> "shop/payments";
+  "shop/payments" -> "shop/orders";
+}
+`, out.String())
+	assert.Equal(t, "Warning: dependency cycle shop/orders -> shop/payments -> shop/orders\n", errOut.String())
+
+	assert.Error(t, runGraph([]string{"-f", "missing.yaml"}, nil, out, ioutil.Discard))
+}
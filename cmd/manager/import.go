@@ -0,0 +1,138 @@
+// Copyright (c) 2020, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/verrazzano/verrazzano-helidon-app-operator/pkg/importer"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/config"
+	"sigs.k8s.io/yaml"
+)
+
+// runImport prints a HelidonApp equivalent to an existing Deployment and its Service, read from files or
+// from the cluster. The settings that can't be represented in the HelidonApp are reported on stderr.
+func runImport(args []string, stdin io.Reader, stdout io.Writer, stderr io.Writer) error {
+	flags := flag.NewFlagSet("import", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	var files fileList
+	flags.Var(&files, "f", "file holding the Deployment and Service, - for stdin. Can be repeated. Reads from the cluster if not set")
+	namespace := flags.String("namespace", "default", "namespace of the Deployment, or of resources read from files without one")
+	flags.Usage = func() {
+		fmt.Fprintf(flags.Output(), "Usage: %s import [-f FILE]... [-namespace NAMESPACE] [DEPLOYMENT]\n", os.Args[0])
+		fmt.Fprintln(flags.Output(), "Prints a HelidonApp equivalent to an existing Deployment and its Service.")
+		flags.PrintDefaults()
+	}
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if flags.NArg() > 1 {
+		flags.Usage()
+		return errors.New("only one Deployment can be imported at a time")
+	}
+	name := flags.Arg(0)
+
+	var deployment *appsv1.Deployment
+	var services []corev1.Service
+	var err error
+	if len(files) > 0 {
+		deployment, services, err = readImportFiles(files, *namespace, name, stdin)
+	} else {
+		if name == "" {
+			flags.Usage()
+			return errors.New("the name of the Deployment is required when reading from the cluster")
+		}
+		deployment, services, err = readImportCluster(*namespace, name)
+	}
+	if err != nil {
+		return err
+	}
+
+	app, warnings := importer.FromDeployment(deployment, importer.FindService(deployment, services))
+	for _, warning := range warnings {
+		fmt.Fprintf(stderr, "Warning: %s\n", warning)
+	}
+	data, err := yaml.Marshal(app)
+	if err != nil {
+		return err
+	}
+	_, err = stdout.Write(data)
+	return err
+}
+
+// readImportFiles reads the Deployment with the given name, or the only Deployment if name is empty, and the
+// Services from the files. Resources without a namespace are put in the given namespace.
+func readImportFiles(files []string, namespace string, name string, stdin io.Reader) (*appsv1.Deployment, []corev1.Service, error) {
+	var deployments []appsv1.Deployment
+	var services []corev1.Service
+	err := readFiles(files, stdin, func(file string, in io.Reader) error {
+		d, s, err := importer.ReadResources(in)
+		if err != nil {
+			return fmt.Errorf("failed to read resources from %s: %s", file, err.Error())
+		}
+		deployments = append(deployments, d...)
+		services = append(services, s...)
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var found []appsv1.Deployment
+	for _, d := range deployments {
+		if name == "" || d.Name == name {
+			found = append(found, d)
+		}
+	}
+	switch {
+	case len(found) == 0 && name != "":
+		return nil, nil, fmt.Errorf("Deployment %s not found", name)
+	case len(found) == 0:
+		return nil, nil, errors.New("no Deployment found")
+	case len(found) > 1:
+		return nil, nil, errors.New("more than one Deployment found, give the name of the Deployment to import")
+	}
+
+	deployment := &found[0]
+	if deployment.Namespace == "" {
+		deployment.Namespace = namespace
+	}
+	for i := range services {
+		if services[i].Namespace == "" {
+			services[i].Namespace = namespace
+		}
+	}
+	return deployment, services, nil
+}
+
+// readImportCluster reads the Deployment and the Services in its namespace from the cluster
+func readImportCluster(namespace string, name string) (*appsv1.Deployment, []corev1.Service, error) {
+	cfg, err := config.GetConfig()
+	if err != nil {
+		return nil, nil, err
+	}
+	c, err := client.New(cfg, client.Options{Scheme: scheme.Scheme})
+	if err != nil {
+		return nil, nil, err
+	}
+	deployment := &appsv1.Deployment{}
+	if err := c.Get(context.TODO(), types.NamespacedName{Namespace: namespace, Name: name}, deployment); err != nil {
+		return nil, nil, err
+	}
+	services := &corev1.ServiceList{}
+	if err := c.List(context.TODO(), services, client.InNamespace(namespace)); err != nil {
+		return nil, nil, err
+	}
+	return deployment, services.Items, nil
+}
@@ -0,0 +1,60 @@
+// Copyright (c) 2020, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const importTestResources = `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: hello
+spec:
+  selector:
+    matchLabels:
+      app: hello
+  template:
+    metadata:
+      labels:
+        app: hello
+    spec:
+      containers:
+      - name: hello
+        image: hello:1.0
+        args: ["--verbose"]
+---
+apiVersion: v1
+kind: Service
+metadata:
+  name: hello
+spec:
+  selector:
+    app: hello
+  ports:
+  - port: 8010
+`
+
+// Test importing a Deployment and Service from stdin
+func TestRunImport(t *testing.T) {
+	out := &bytes.Buffer{}
+	errOut := &bytes.Buffer{}
+	assert.NoError(t, runImport([]string{"-f", "-", "-namespace", "myns"}, strings.NewReader(importTestResources), out, errOut))
+	assert.Contains(t, out.String(), "kind: HelidonApp")
+	assert.Contains(t, out.String(), "namespace: myns")
+	assert.Contains(t, out.String(), "image: hello:1.0")
+	assert.Contains(t, out.String(), "port: 8010")
+	assert.Contains(t, errOut.String(), "command and args are not supported")
+
+	err := runImport([]string{"-f", "-", "other"}, strings.NewReader(importTestResources), out, ioutil.Discard)
+	assert.Error(t, err, "Expected error for missing Deployment")
+	err = runImport(nil, nil, out, ioutil.Discard)
+	assert.Error(t, err, "Expected error for missing Deployment name")
+}
@@ -18,6 +18,12 @@ import (
 	sdkVersion "github.com/operator-framework/operator-sdk/version"
 	"github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis"
 	"github.com/verrazzano/verrazzano-helidon-app-operator/pkg/controller"
+	"github.com/verrazzano/verrazzano-helidon-app-operator/pkg/controller/helidonapp"
+	"github.com/verrazzano/verrazzano-helidon-app-operator/pkg/dependencies"
+	"github.com/verrazzano/verrazzano-helidon-app-operator/pkg/helidon"
+	"github.com/verrazzano/verrazzano-helidon-app-operator/pkg/namespaces"
+	"github.com/verrazzano/verrazzano-helidon-app-operator/pkg/tenancy"
+	"github.com/verrazzano/verrazzano-helidon-app-operator/pkg/webhook"
 	"github.com/verrazzano/verrazzano-helidon-app-operator/version"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
@@ -37,6 +43,7 @@ var (
 	metricsHost               = "0.0.0.0"
 	metricsPort         int32 = 8383
 	operatorMetricsPort int32 = 8686
+	webhookPort               = 9443
 	zapOptions                = kzap.Options{}
 )
 
@@ -48,8 +55,27 @@ func printVersion() {
 }
 
 func main() {
+	// Run a subcommand instead of the operator if one is given
+	if len(os.Args) > 1 {
+		if cmd, ok := commands[os.Args[1]]; ok {
+			if err := cmd(os.Args[2:], os.Stdin, os.Stdout, os.Stderr); err != nil {
+				fmt.Fprintln(os.Stderr, err)
+				os.Exit(1)
+			}
+			return
+		}
+	}
+
 	// Add the zap logger flag set to the CLI.
 	zapOptions.BindFlags(flag.CommandLine)
+	flag.BoolVar(&helidonapp.ControllerOptions.PlanMode, "plan", false,
+		"Record the changes to the resources of HelidonApps in their status instead of applying them")
+	flag.StringVar(&helidon.DefaultTracingEndpoint, "tracing-endpoint", "",
+		"Collector endpoint of HelidonApps enabling spec.tracing without an endpoint")
+	flag.StringVar(&dependencies.WaitForImage, "wait-for-image", dependencies.WaitForImage,
+		"Image of the init containers waiting for the spec.dependsOn of HelidonApps, it needs sh and nc")
+	allowedServiceAccounts := flag.String("allowed-service-accounts", "",
+		"Comma separated names of the ServiceAccounts HelidonApps may run as, * allows any ServiceAccount")
 	flag.Parse()
 	//Initialize structured logging
 	InitLogs(zapOptions)
@@ -60,6 +86,25 @@ func main() {
 		zap.S().Errorf("Failed to get watch namespace: %s", err)
 		os.Exit(1)
 	}
+	// WATCH_NAMESPACE may hold a comma separated list of namespaces
+	watchNamespaces := namespaces.ParseWatchNamespaces(namespace)
+
+	// Alternatively, watch the namespaces matching a label selector
+	namespaceSelector, err := namespaces.GetWatchNamespaceSelector()
+	if err != nil {
+		zap.S().Errorf("Failed to parse %s: %s", namespaces.WatchNamespaceSelectorEnvVar, err)
+		os.Exit(1)
+	}
+	if namespaceSelector != nil && len(watchNamespaces) > 0 {
+		zap.S().Errorf("WATCH_NAMESPACE must be empty when %s is set", namespaces.WatchNamespaceSelectorEnvVar)
+		os.Exit(1)
+	}
+	helidonapp.ControllerOptions.NamespaceSelector = namespaceSelector
+
+	// The tenancy policy is enforced both by the controller and the admission webhook
+	tenancyPolicy := tenancy.Policy{AllowedServiceAccounts: tenancy.ParseServiceAccounts(*allowedServiceAccounts)}
+	helidonapp.ControllerOptions.Tenancy = tenancyPolicy
+	webhook.WebhookOptions.Tenancy = tenancyPolicy
 
 	// Get a config to talk to the apiserver
 	cfg, err := config.GetConfig()
@@ -78,10 +123,12 @@ func main() {
 	}
 
 	// Create a new Cmd to provide shared dependencies and start components
-	mgr, err := manager.New(cfg, manager.Options{
-		Namespace:          namespace,
+	options := manager.Options{
 		MetricsBindAddress: fmt.Sprintf("%s:%d", metricsHost, metricsPort),
-	})
+		Port:               webhookPort,
+	}
+	namespaces.ConfigureManagerOptions(&options, watchNamespaces)
+	mgr, err := manager.New(cfg, options)
 	if err != nil {
 		zap.S().Error(err)
 		os.Exit(1)
@@ -101,6 +148,12 @@ func main() {
 		os.Exit(1)
 	}
 
+	// Setup all Webhooks
+	if err := webhook.AddToManager(mgr); err != nil {
+		zap.S().Error(err)
+		os.Exit(1)
+	}
+
 	if err = serveCRMetrics(cfg); err != nil {
 		zap.S().Warnf("Could not generate and serve custom resource metrics, error: %s", err.Error())
 	}
@@ -119,7 +172,12 @@ func main() {
 	// CreateServiceMonitors will automatically create the prometheus-operator ServiceMonitor resources
 	// necessary to configure Prometheus to scrape metrics from this operator.
 	services := []*v1.Service{service}
-	_, err = metrics.CreateServiceMonitors(cfg, namespace, services)
+	serviceMonitorNamespace := namespace
+	if len(watchNamespaces) > 1 && service != nil {
+		// The metrics Service is created in the operator namespace
+		serviceMonitorNamespace = service.Namespace
+	}
+	_, err = metrics.CreateServiceMonitors(cfg, serviceMonitorNamespace, services)
 	if err != nil {
 		zap.S().Warnf("Could not create ServiceMonitor object, error: %s", err.Error())
 		// If this operator is deployed to a cluster without the prometheus-operator running, it will return
@@ -0,0 +1,46 @@
+// Copyright (c) 2020, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/verrazzano/verrazzano-helidon-app-operator/pkg/helidon"
+	"github.com/verrazzano/verrazzano-helidon-app-operator/pkg/render"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// runRender prints the manifests generated for the HelidonApps read from the files in args, or from stdin
+// when no file or "-" is given. The cluster is not contacted.
+func runRender(args []string, stdin io.Reader, stdout io.Writer, stderr io.Writer) error {
+	flags := flag.NewFlagSet("render", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	flags.StringVar(&helidon.DefaultTracingEndpoint, "tracing-endpoint", "",
+		"Collector endpoint of HelidonApps enabling spec.tracing without an endpoint")
+	flags.Usage = func() {
+		fmt.Fprintf(flags.Output(), "Usage: %s render [--tracing-endpoint URL] [FILE|-]...\n", os.Args[0])
+		fmt.Fprintln(flags.Output(), "Prints the resources generated for the HelidonApps in the files, or stdin.")
+		flags.PrintDefaults()
+	}
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	files := flags.Args()
+	if len(files) == 0 {
+		files = []string{"-"}
+	}
+
+	apps, err := readHelidonApps(files, stdin)
+	if err != nil {
+		return err
+	}
+	var objects []runtime.Object
+	for _, app := range apps {
+		objects = append(objects, render.Manifests(app)...)
+	}
+	return render.WriteManifests(stdout, objects)
+}
@@ -0,0 +1,58 @@
+// Copyright (c) 2020, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/verrazzano/verrazzano-helidon-app-operator/pkg/helidon"
+)
+
+const renderTestApp = `
+apiVersion: verrazzano.io/v1
+kind: HelidonApp
+metadata:
+  name: hello
+  namespace: default
+spec:
+  name: hello
+  namespace: hello-ns
+  container:
+    image: hello:1.0
+`
+
+// Test rendering HelidonApps from stdin and from files
+func TestRunRender(t *testing.T) {
+	out := &bytes.Buffer{}
+	assert.NoError(t, runRender(nil, strings.NewReader(renderTestApp), out, ioutil.Discard))
+	assert.Contains(t, out.String(), "kind: Deployment")
+	assert.Contains(t, out.String(), "kind: Service")
+
+	dir, err := ioutil.TempDir("", "render")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+	file := filepath.Join(dir, "app.yaml")
+	assert.NoError(t, ioutil.WriteFile(file, []byte(renderTestApp), 0600))
+
+	out.Reset()
+	assert.NoError(t, runRender([]string{file, "-"}, strings.NewReader(renderTestApp), out, ioutil.Discard))
+	assert.Equal(t, 2, strings.Count(out.String(), "kind: Deployment"))
+
+	assert.Error(t, runRender([]string{filepath.Join(dir, "missing.yaml")}, nil, out, ioutil.Discard))
+}
+
+// Test rendering a HelidonApp enabling tracing with the default endpoint given on the command line
+func TestRunRenderTracingEndpoint(t *testing.T) {
+	defer func() { helidon.DefaultTracingEndpoint = "" }()
+	app := renderTestApp + "  tracing:\n    enabled: true\n"
+	out := &bytes.Buffer{}
+	assert.NoError(t, runRender([]string{"--tracing-endpoint", "http://collector:4318"}, strings.NewReader(app), out, ioutil.Discard))
+	assert.Contains(t, out.String(), "value: http://collector:4318")
+}
@@ -0,0 +1,19 @@
+#!/bin/bash
+# Copyright (c) 2020, Oracle and/or its affiliates.
+# Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+# Add the conversion webhook to the generated HelidonApp CRD - controller-gen does not generate
+# the conversion section for multi-version CRDs
+
+set -o errexit
+set -o nounset
+set -o pipefail
+
+SCRIPT_ROOT=$(dirname $0)/..
+CRD_CONVERSION=$(dirname $0)/crd-conversion.yaml.txt
+CRD_FILENAME=$SCRIPT_ROOT/deploy/crds/verrazzano.io_helidonapps_crd.yaml
+
+echo "Adding conversion webhook from $CRD_CONVERSION to generated CRD file $CRD_FILENAME"
+TMP_CRD=${CRD_FILENAME}.tmp
+sed -e "/^spec:$/r $CRD_CONVERSION" $CRD_FILENAME > $TMP_CRD
+mv $TMP_CRD $CRD_FILENAME
@@ -9,13 +9,19 @@ set -o pipefail
 SCRIPT_ROOT=$(dirname $0)/..
 CODEGEN_PKG=${CODEGEN_PKG:-$(cd ${SCRIPT_ROOT}; ls -d -1 ./vendor/k8s.io/code-generator 2>/dev/null || echo ../code-generator)}
 
-GENERATED_ZZ_FILE=$SCRIPT_ROOT/pkg/apis/verrazzano/v1beta1/zz_generated.deepcopy.go
-echo Remove $GENERATED_ZZ_FILE file if exist
-rm -f $GENERATED_ZZ_FILE
+for VERSION in v1beta1 v1 ; do
+  GENERATED_ZZ_FILE=$SCRIPT_ROOT/pkg/apis/verrazzano/$VERSION/zz_generated.deepcopy.go
+  echo Remove $GENERATED_ZZ_FILE file if exist
+  rm -f $GENERATED_ZZ_FILE
+done
 
 GENERATED_CLIENT_DIR=$SCRIPT_ROOT/pkg/client
-echo Remove $GENERATED_CLIENT_DIR dir if exist
-rm -rf $GENERATED_CLIENT_DIR
+echo Remove the generated files of $GENERATED_CLIENT_DIR dir if exist
+# The Apply and ApplyStatus expansions and the tests are written by hand, client-gen leaves the expansion
+# interfaces with a <type>_expansion.go file out of generated_expansion.go
+if [ -d $GENERATED_CLIENT_DIR ]; then
+  grep -rl --include='*.go' 'Code generated by .*-gen. DO NOT EDIT.' $GENERATED_CLIENT_DIR | xargs rm -f
+fi
 
 # generate the code with:
 # --output-base    because this script should also be able to run inside the vendor dir of
@@ -23,6 +29,6 @@ rm -rf $GENERATED_CLIENT_DIR
 #                  instead of the $GOPATH directly. For normal projects this can be dropped.
 ${CODEGEN_PKG}/generate-groups.sh "deepcopy,client,informer,lister" \
   github.com/verrazzano/verrazzano-helidon-app-operator/pkg/client github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis \
-  verrazzano:v1beta1 \
+  verrazzano:v1beta1,v1 \
   --output-base "${GOPATH}/src" \
   --go-header-file ${SCRIPT_ROOT}/hack/custom-header.txt
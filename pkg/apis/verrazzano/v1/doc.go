@@ -0,0 +1,7 @@
+// Copyright (c) 2020, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+// Package v1 contains API Schema definitions for the verrazzano v1 API group
+// +k8s:deepcopy-gen=package,register
+// +groupName=verrazzano.io
+package v1
@@ -0,0 +1,7 @@
+// Copyright (c) 2020, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package v1
+
+// Hub marks v1 as the version all other HelidonApp versions are converted to and from
+func (*HelidonApp) Hub() {}
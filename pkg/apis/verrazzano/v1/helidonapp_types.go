@@ -0,0 +1,625 @@
+// Copyright (c) 2020, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package v1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// HelidonAppSpec defines the desired state of HelidonApp
+// +k8s:openapi-gen=true
+type HelidonAppSpec struct {
+	// User defined description of the the HelidonApp custom resource
+	Description string `json:"description"`
+	// The name of the Helidon application
+	Name string `json:"name"`
+	// The namespace for the Helidon application
+	Namespace string `json:"namespace"`
+	// The Kubernetes ServiceAccount name to run this pod
+	ServiceAccountName string `json:"serviceAccountName,omitempty"`
+	// The main Helidon application container
+	Container ContainerSpec `json:"container"`
+	// The Helidon flavor and version of the application, which drive the defaults of the probes, metrics and
+	// configuration. Probes are only generated when set.
+	Helidon *HelidonSpec `json:"helidon,omitempty"`
+	// The runtime of the application, jvm or a GraalVM native executable, which drives the defaults of the
+	// resources and probes. The defaults only apply when set, and the probe delays only when spec.helidon is set.
+	// The native runtime rejects JVM and JMX options.
+	// +kubebuilder:validation:Enum=jvm;native
+	Runtime HelidonRuntime `json:"runtime,omitempty"`
+	// Remote debugging of the main container with the JDWP agent, only supported by the jvm runtime
+	Debug *DebugSpec `json:"debug,omitempty"`
+	// The Service exposing the Helidon application
+	Service ServiceSpec `json:"service,omitempty"`
+	// Scaling of the Helidon application
+	Scaling ScalingSpec `json:"scaling,omitempty"`
+	// Observability of the Helidon application
+	Observability ObservabilitySpec `json:"observability,omitempty"`
+	// Distributed tracing of the Helidon application, configured with environment variables of the main container
+	Tracing *TracingSpec `json:"tracing,omitempty"`
+	// Log levels and format of the Helidon application, rendered into a ConfigMap mounted in the main container
+	Logging *LoggingSpec `json:"logging,omitempty"`
+	// Databases and backing services projected into the main container, following the servicebinding.io
+	// specification
+	// +x-kubernetes-list-type=map
+	// +x-kubernetes-list-map-keys=name
+	Bindings []ServiceBinding `json:"bindings,omitempty"`
+	// Access of the Helidon application to an Oracle Database
+	Database *DatabaseSpec `json:"database,omitempty"`
+	// Other HelidonApps that must be Ready before the Deployment is created or its pods are upgraded
+	// +x-kubernetes-list-type=atomic
+	DependsOn []Dependency `json:"dependsOn,omitempty"`
+	// Jobs run around the rollouts of the Helidon application
+	Hooks *HooksSpec `json:"hooks,omitempty"`
+	// InitContainers holds a list of initialization containers that should
+	// be run before starting the main container in this pod.
+	// +x-kubernetes-list-type=set
+	InitContainers []corev1.Container `json:"initContainers,omitempty"`
+	// Additional containers to be included in the pod
+	// +x-kubernetes-list-type=set
+	Containers []corev1.Container `json:"containers,omitempty"`
+	// Volumes to be created in the pod
+	// +x-kubernetes-list-type=set
+	Volumes []corev1.Volume `json:"volumes,omitempty"`
+	// Changing the timestamp restarts the pods of the Helidon application with a rolling update
+	RestartAt *metav1.Time `json:"restartAt,omitempty"`
+	// Scales the Deployment to zero replicas, the Service and the configuration are kept
+	Suspend bool `json:"suspend,omitempty"`
+	// Windows overriding the replicas or suspending the Helidon application. The first active window applies.
+	// +x-kubernetes-list-type=atomic
+	Schedule []ScheduleEntry `json:"schedule,omitempty"`
+}
+
+// ContainerSpec defines the main Helidon application container
+// +k8s:openapi-gen=true
+type ContainerSpec struct {
+	// The docker image to pull
+	Image string `json:"image"`
+	// The Kubernetes docker secrets for pulling images
+	// +x-kubernetes-list-type=set
+	ImagePullSecrets []corev1.LocalObjectReference `json:"imagePullSecrets,omitempty"`
+	// The Kubernetes pull policy for pulling the image
+	ImagePullPolicy corev1.PullPolicy `json:"imagePullPolicy,omitempty"`
+	// Array of environment variables for image
+	// +x-kubernetes-list-type=set
+	Env []corev1.EnvVar `json:"env,omitempty"`
+	// Compute resources of the container - defaults to the memory request of spec.runtime
+	Resources *corev1.ResourceRequirements `json:"resources,omitempty"`
+}
+
+// ScheduleEntry defines a window, from a start to an end given as cron expressions, during which the replicas
+// of the Helidon application are overridden
+// +k8s:openapi-gen=true
+type ScheduleEntry struct {
+	// The name of the entry, shown in the status while the window is active
+	Name string `json:"name"`
+	// The cron expression of the start of the window, for example "0 20 * * 1-5"
+	Start string `json:"start"`
+	// The cron expression of the end of the window, for example "0 7 * * 1-5"
+	End string `json:"end"`
+	// The IANA time zone of the cron expressions, defaults to UTC
+	TimeZone string `json:"timeZone,omitempty"`
+	// The replicas during the window
+	Replicas *int32 `json:"replicas,omitempty"`
+	// Scales the Deployment to zero replicas during the window
+	Suspend bool `json:"suspend,omitempty"`
+}
+
+// HelidonFlavor is the flavor of Helidon the application is built with
+type HelidonFlavor string
+
+const (
+	// HelidonFlavorSE is Helidon SE
+	HelidonFlavorSE HelidonFlavor = "SE"
+	// HelidonFlavorMP is Helidon MP, the MicroProfile implementation
+	HelidonFlavorMP HelidonFlavor = "MP"
+)
+
+// HelidonRuntime is the runtime the Helidon application runs on
+type HelidonRuntime string
+
+const (
+	// HelidonRuntimeJVM runs the application on a Java virtual machine
+	HelidonRuntimeJVM HelidonRuntime = "jvm"
+	// HelidonRuntimeNative runs the application as a GraalVM native executable
+	HelidonRuntimeNative HelidonRuntime = "native"
+)
+
+// HelidonSpec defines the Helidon flavor and version of the application
+// +k8s:openapi-gen=true
+type HelidonSpec struct {
+	// The Helidon flavor, SE or MP - defaults to SE
+	// +kubebuilder:validation:Enum=SE;MP
+	Flavor HelidonFlavor `json:"flavor,omitempty"`
+	// The Helidon version, like 2.4.2 or 4 - defaults to 2
+	Version string `json:"version,omitempty"`
+	// The configuration profile, set with the environment variable of the flavor and version
+	ConfigProfile string `json:"configProfile,omitempty"`
+	// A ConfigMap holding configuration files, mounted at /conf in the main container
+	ConfigMap string `json:"configMap,omitempty"`
+}
+
+// DebugSpec defines the remote debugging of the Helidon application
+// +k8s:openapi-gen=true
+type DebugSpec struct {
+	// Whether the JDWP agent is enabled. While enabled the Deployment runs a single replica without liveness probe.
+	Enabled bool `json:"enabled"`
+	// The port of the JDWP agent, exposed on the container but not on the Service - defaults to 5005
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	Port int32 `json:"port,omitempty"`
+	// Whether the application waits for a debugger to attach before it starts
+	Suspend bool `json:"suspend,omitempty"`
+}
+
+// ServiceSpec defines the Service exposing the Helidon application
+// +k8s:openapi-gen=true
+type ServiceSpec struct {
+	// Port to be used for service and container - defaults to 8080
+	Port int32 `json:"port,omitempty"`
+	// Port to be used for service targetPort - defaults to port
+	TargetPort int32 `json:"targetPort,omitempty"`
+}
+
+// ScalingSpec defines the scaling of the Helidon application
+// +k8s:openapi-gen=true
+type ScalingSpec struct {
+	// Number of replicas to create.
+	// This is a pointer to distinguish between explicit zero and not specified.
+	// Defaults to 1.
+	Replicas *int32 `json:"replicas,omitempty"`
+}
+
+// ObservabilitySpec defines how the Helidon application is observed
+// +k8s:openapi-gen=true
+type ObservabilitySpec struct {
+	// Prometheus metrics scraping
+	Metrics MetricsSpec `json:"metrics,omitempty"`
+}
+
+// MetricsSpec defines how Prometheus scrapes the Helidon application
+// +k8s:openapi-gen=true
+type MetricsSpec struct {
+	// Whether Prometheus scrapes the application - defaults to true
+	Enabled *bool `json:"enabled,omitempty"`
+	// Path of the metrics endpoint - defaults to the metrics path of the Helidon version, /metrics before
+	// Helidon 4
+	Path string `json:"path,omitempty"`
+}
+
+// TracingProvider is the distributed tracing system receiving the spans of the Helidon application
+type TracingProvider string
+
+const (
+	// TracingProviderOpenTelemetry exports spans with the OpenTelemetry protocol
+	TracingProviderOpenTelemetry TracingProvider = "opentelemetry"
+	// TracingProviderJaeger exports spans to a Jaeger collector
+	TracingProviderJaeger TracingProvider = "jaeger"
+	// TracingProviderZipkin exports spans to a Zipkin collector
+	TracingProviderZipkin TracingProvider = "zipkin"
+)
+
+// TracingSpec defines the distributed tracing of the Helidon application
+// +k8s:openapi-gen=true
+type TracingSpec struct {
+	// Whether the application sends spans
+	Enabled bool `json:"enabled"`
+	// The tracing system, opentelemetry, jaeger or zipkin - defaults to opentelemetry
+	// +kubebuilder:validation:Enum=opentelemetry;jaeger;zipkin
+	Provider TracingProvider `json:"provider,omitempty"`
+	// The URL of the collector, like http://jaeger-collector.monitoring:14268/api/traces - defaults to the
+	// operator wide endpoint
+	Endpoint string `json:"endpoint,omitempty"`
+	// The fraction of the traces sampled, between 0 and 1 like "0.1" - defaults to the sampler of the provider
+	// +kubebuilder:validation:Pattern=`^(0(\.[0-9]+)?|1(\.0+)?)$`
+	SamplingRate string `json:"samplingRate,omitempty"`
+	// The service name of the spans - defaults to spec.name
+	ServiceName string `json:"serviceName,omitempty"`
+}
+
+// LogLevel is the level of a logger
+type LogLevel string
+
+const (
+	// LogLevelTrace logs everything
+	LogLevelTrace LogLevel = "TRACE"
+	// LogLevelDebug logs debugging messages and above
+	LogLevelDebug LogLevel = "DEBUG"
+	// LogLevelInfo logs informational messages and above
+	LogLevelInfo LogLevel = "INFO"
+	// LogLevelWarn logs warnings and errors
+	LogLevelWarn LogLevel = "WARN"
+	// LogLevelError logs errors only
+	LogLevelError LogLevel = "ERROR"
+	// LogLevelOff logs nothing
+	LogLevelOff LogLevel = "OFF"
+)
+
+// LogFormat is the format of the log records
+type LogFormat string
+
+const (
+	// LogFormatPlain writes log records as lines of text
+	LogFormatPlain LogFormat = "plain"
+	// LogFormatJSON writes log records as JSON objects, one per line
+	LogFormatJSON LogFormat = "json"
+)
+
+// LoggingFramework is the logging framework the Helidon application logs with
+type LoggingFramework string
+
+const (
+	// LoggingFrameworkJUL is java.util.logging, used by Helidon by default
+	LoggingFrameworkJUL LoggingFramework = "jul"
+	// LoggingFrameworkLog4j2 is Log4j 2
+	LoggingFrameworkLog4j2 LoggingFramework = "log4j2"
+)
+
+// LoggingSpec defines the log levels and format of the Helidon application
+// +k8s:openapi-gen=true
+type LoggingSpec struct {
+	// The level of the root logger - defaults to INFO
+	// +kubebuilder:validation:Enum=TRACE;DEBUG;INFO;WARN;ERROR;OFF
+	Level LogLevel `json:"level,omitempty"`
+	// The levels of loggers by package or class name, like io.helidon.webserver: DEBUG
+	Levels map[string]LogLevel `json:"levels,omitempty"`
+	// The format of the log records, plain or json - defaults to plain. The json format needs the log4j2 framework
+	// and the org.apache.logging.log4j:log4j-layout-template-json module, 2.14 or later, in the application.
+	// +kubebuilder:validation:Enum=plain;json
+	Format LogFormat `json:"format,omitempty"`
+	// The logging framework of the application, jul or log4j2 - defaults to jul
+	// +kubebuilder:validation:Enum=jul;log4j2
+	Framework LoggingFramework `json:"framework,omitempty"`
+	// Whether Log4j 2 reloads the logging configuration when it changes, instead of restarting the pods. Needs the
+	// log4j2 framework.
+	InPlace bool `json:"inPlace,omitempty"`
+}
+
+// ServiceBinding defines a binding projected into the main container at /bindings/<name>. The binding is read
+// from a Secret, or from the Secret named in status.binding.name of a provisioned service.
+// +k8s:openapi-gen=true
+type ServiceBinding struct {
+	// The name of the binding, the directory under /bindings
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	Name string `json:"name"`
+	// A Secret in spec.namespace holding the binding
+	Secret string `json:"secret,omitempty"`
+	// A provisioned service in spec.namespace, whose status.binding.name is the Secret holding the binding
+	Service *BindingServiceReference `json:"service,omitempty"`
+	// The type of the binding, like oracle, postgresql, mysql or kafka. It selects the well-known keys mapped to
+	// configuration.
+	Type string `json:"type,omitempty"`
+	// Whether the well-known keys of the type are mapped to environment variables of the Helidon configuration,
+	// like the javax.sql.DataSource properties for databases
+	Env bool `json:"env,omitempty"`
+}
+
+// BindingServiceReference refers to a provisioned service
+// +k8s:openapi-gen=true
+type BindingServiceReference struct {
+	// The API version of the provisioned service, like example.com/v1
+	APIVersion string `json:"apiVersion"`
+	// The kind of the provisioned service
+	Kind string `json:"kind"`
+	// The name of the provisioned service
+	Name string `json:"name"`
+}
+
+// BindingStatus records the Secret of a binding to a provisioned service
+// +k8s:openapi-gen=true
+type BindingStatus struct {
+	// The name of the binding
+	Name string `json:"name"`
+	// The Secret named in status.binding.name of the provisioned service
+	Secret string `json:"secret"`
+}
+
+// DatabaseSpec defines the access of the Helidon application to an Oracle Database
+// +k8s:openapi-gen=true
+type DatabaseSpec struct {
+	// The wallet of the database, mounted read only in the main container and used by the Oracle JDBC driver
+	Wallet *WalletSpec `json:"wallet,omitempty"`
+}
+
+// WalletSpec defines an Oracle wallet, like the wallet of an Autonomous Database
+// +k8s:openapi-gen=true
+type WalletSpec struct {
+	// The Secret in spec.namespace holding the wallet files, at least cwallet.sso and tnsnames.ora
+	Secret string `json:"secret"`
+	// The directory of the wallet in the main container - defaults to /wallet
+	MountPath string `json:"mountPath,omitempty"`
+}
+
+// Dependency refers to a HelidonApp the Helidon application depends on
+// +k8s:openapi-gen=true
+type Dependency struct {
+	// The name of the HelidonApp
+	Name string `json:"name"`
+	// The namespace of the HelidonApp - defaults to the namespace of this HelidonApp
+	Namespace string `json:"namespace,omitempty"`
+	// Whether an init container waits for the Service of the dependency to accept connections before the main
+	// container starts
+	WaitFor bool `json:"waitFor,omitempty"`
+}
+
+// DependencyStatus records the Service of a dependency
+// +k8s:openapi-gen=true
+type DependencyStatus struct {
+	// The name of the HelidonApp
+	Name string `json:"name"`
+	// The namespace of the HelidonApp
+	Namespace string `json:"namespace"`
+	// The host and port of the Service of the dependency
+	Address string `json:"address,omitempty"`
+	// Whether the dependency is Ready
+	Ready bool `json:"ready"`
+}
+
+// HooksSpec defines the Jobs run around the rollouts of the Helidon application
+// +k8s:openapi-gen=true
+type HooksSpec struct {
+	// A Job run before a new image or configuration is rolled out, like a database schema migration. The
+	// Deployment is only created or updated once it succeeded.
+	PreDeploy *HookSpec `json:"preDeploy,omitempty"`
+	// A Job run once a new image or configuration is fully available, like smoke tests of the endpoints of the
+	// new pods. When it fails, the rollback policy applies.
+	PostDeploy *HookSpec `json:"postDeploy,omitempty"`
+	// What the operator does when the post-deploy Job fails, Automatic restores the last known good pod template
+	// and None leaves the pods as they are - defaults to Automatic
+	// +kubebuilder:validation:Enum=Automatic;None
+	RollbackPolicy RollbackPolicy `json:"rollbackPolicy,omitempty"`
+	// The number of completed hook Jobs kept, the Job of the current image and configuration is always kept -
+	// defaults to 3
+	// +kubebuilder:validation:Minimum=0
+	HistoryLimit *int32 `json:"historyLimit,omitempty"`
+}
+
+// RollbackPolicy is what the operator does when the post-deploy Job of a revision fails
+type RollbackPolicy string
+
+const (
+	// RollbackAutomatic restores the last known good pod template, the failed revision isn't rolled out again
+	RollbackAutomatic RollbackPolicy = "Automatic"
+	// RollbackNone leaves the failed revision running
+	RollbackNone RollbackPolicy = "None"
+)
+
+// HookSpec defines a Job run by the operator in spec.namespace. Its container gets the environment variables,
+// volumes and image pull secrets of the main container, and runs with the ServiceAccount of the pods.
+// +k8s:openapi-gen=true
+type HookSpec struct {
+	// The image of the Job - defaults to the image of spec.container
+	Image string `json:"image,omitempty"`
+	// The command of the container - defaults to the entrypoint of the image
+	// +x-kubernetes-list-type=atomic
+	Command []string `json:"command,omitempty"`
+	// The arguments of the command
+	// +x-kubernetes-list-type=atomic
+	Args []string `json:"args,omitempty"`
+	// Environment variables added to the ones of the main container
+	// +x-kubernetes-list-type=atomic
+	Env []corev1.EnvVar `json:"env,omitempty"`
+	// The number of retries before the Job fails - defaults to 0
+	// +kubebuilder:validation:Minimum=0
+	BackoffLimit *int32 `json:"backoffLimit,omitempty"`
+	// The number of seconds the Job may run before it fails
+	// +kubebuilder:validation:Minimum=1
+	ActiveDeadlineSeconds *int64 `json:"activeDeadlineSeconds,omitempty"`
+}
+
+// HookPhase is the phase of the Job of a hook
+type HookPhase string
+
+const (
+	// HookRunning means the Job didn't complete yet
+	HookRunning HookPhase = "Running"
+	// HookSucceeded means the Job completed successfully
+	HookSucceeded HookPhase = "Succeeded"
+	// HookFailed means the Job failed, it is not retried for the same image and configuration
+	HookFailed HookPhase = "Failed"
+)
+
+// HookStatus describes the last Job of a hook
+// +k8s:openapi-gen=true
+type HookStatus struct {
+	// The name of the Job, in spec.namespace
+	Job string `json:"job"`
+	// The revision of the image and configuration the Job ran for
+	Revision string `json:"revision"`
+	// The phase of the Job, Running, Succeeded or Failed
+	Phase HookPhase `json:"phase"`
+	// Where to find the logs of the pods of the Job
+	Logs string `json:"logs,omitempty"`
+}
+
+// KnownGoodRevision is a pod template that was fully available and passed the post-deploy Job
+// +k8s:openapi-gen=true
+type KnownGoodRevision struct {
+	// The revision of the image and configuration
+	Revision string `json:"revision"`
+	// The pod template of the Deployment
+	// +kubebuilder:pruning:PreserveUnknownFields
+	Template runtime.RawExtension `json:"template"`
+}
+
+// HelidonAppStatus defines the observed state of HelidonApp
+// +k8s:openapi-gen=true
+type HelidonAppStatus struct {
+	// State of the Helidon deployment
+	State string `json:"state,omitempty"`
+	// Message associated with latest action
+	LastActionMessage string `json:"lastActionMessage,omitempty"`
+	// Time stamp for latest action
+	LastActionTime string `json:"lastActionTime,omitempty"`
+	// Name of the Deployment and Service last applied for the Helidon application
+	AppliedName string `json:"appliedName,omitempty"`
+	// Namespace of the Deployment and Service last applied for the Helidon application
+	AppliedNamespace string `json:"appliedNamespace,omitempty"`
+	// Number of replicas of the Helidon application Deployment observed by its controller
+	Replicas int32 `json:"replicas,omitempty"`
+	// Number of ready replicas of the Helidon application Deployment
+	ReadyReplicas int32 `json:"readyReplicas,omitempty"`
+	// Label selector of the Helidon application pods, used by the scale subresource
+	Selector string `json:"selector,omitempty"`
+	// Latest observations of the state of the Helidon application
+	// +x-kubernetes-list-type=map
+	// +x-kubernetes-list-map-keys=type
+	Conditions []Condition `json:"conditions,omitempty"`
+	// Changes the operator would apply to the resources of the Helidon application, when in plan mode
+	PlannedChanges []PlannedChange `json:"plannedChanges,omitempty"`
+	// The time the operator last restarted the pods because of a change of spec.restartAt
+	LastRestartTime *metav1.Time `json:"lastRestartTime,omitempty"`
+	// The name of the active spec.schedule entry
+	ActiveSchedule string `json:"activeSchedule,omitempty"`
+	// The time the next spec.schedule window starts or ends
+	NextScheduleTime *metav1.Time `json:"nextScheduleTime,omitempty"`
+	// The problems of the containers of the pods of the Helidon application
+	// +x-kubernetes-list-type=atomic
+	PodIssues []PodIssue `json:"podIssues,omitempty"`
+	// The Secrets of the spec.bindings to provisioned services
+	// +x-kubernetes-list-type=map
+	// +x-kubernetes-list-map-keys=name
+	Bindings []BindingStatus `json:"bindings,omitempty"`
+	// The Services and readiness of spec.dependsOn
+	// +x-kubernetes-list-type=atomic
+	Dependencies []DependencyStatus `json:"dependencies,omitempty"`
+	// The last Job of spec.hooks.preDeploy
+	PreDeployHook *HookStatus `json:"preDeployHook,omitempty"`
+	// The last Job of spec.hooks.postDeploy
+	PostDeployHook *HookStatus `json:"postDeployHook,omitempty"`
+	// The last revision that passed spec.hooks.postDeploy, restored when the post-deploy Job of a later revision
+	// fails
+	LastKnownGood *KnownGoodRevision `json:"lastKnownGood,omitempty"`
+}
+
+// ConditionType is the type of a HelidonApp condition
+type ConditionType string
+
+const (
+	// ConditionReady indicates all the desired replicas of the Helidon application are available
+	ConditionReady ConditionType = "Ready"
+	// ConditionPolicyViolation indicates the HelidonApp violates the tenancy or image policy, its resources are
+	// not created or updated
+	ConditionPolicyViolation ConditionType = "PolicyViolation"
+	// ConditionDegraded indicates pods of the Helidon application have problems, see the pod issues of the status
+	ConditionDegraded ConditionType = "Degraded"
+	// ConditionDebugging indicates spec.debug is enabled, the Helidon application runs with the JDWP agent
+	ConditionDebugging ConditionType = "Debugging"
+	// ConditionWaitingForDependencies indicates HelidonApps of spec.dependsOn are not Ready, or depend on each
+	// other in a cycle. The Deployment is not created or upgraded.
+	ConditionWaitingForDependencies ConditionType = "WaitingForDependencies"
+	// ConditionRolledBack indicates the post-deploy Job of a revision failed and the last known good pod template
+	// was restored. The message names the failed Job.
+	ConditionRolledBack ConditionType = "RolledBack"
+)
+
+// Condition describes the state of the Helidon application at a certain point
+// +k8s:openapi-gen=true
+type Condition struct {
+	// Type of the condition
+	Type ConditionType `json:"type"`
+	// Status of the condition, one of True, False or Unknown
+	Status corev1.ConditionStatus `json:"status"`
+	// Last time the condition transitioned from one status to another
+	LastTransitionTime metav1.Time `json:"lastTransitionTime,omitempty"`
+	// Machine readable reason for the last transition
+	Reason string `json:"reason,omitempty"`
+	// Human readable message for the last transition
+	Message string `json:"message,omitempty"`
+}
+
+// PodIssue describes the problem of a pod, or of one of its containers
+// +k8s:openapi-gen=true
+type PodIssue struct {
+	// The name of the pod
+	Pod string `json:"pod"`
+	// The name of the container, empty for problems of the pod itself
+	Container string `json:"container,omitempty"`
+	// The reason of the problem, like ImagePullBackOff, CrashLoopBackOff or Unschedulable
+	Reason string `json:"reason"`
+	// The message of the problem
+	Message string `json:"message,omitempty"`
+	// The number of times the container restarted
+	RestartCount int32 `json:"restartCount,omitempty"`
+	// The reason the container last terminated, like OOMKilled
+	LastTerminationReason string `json:"lastTerminationReason,omitempty"`
+	// The message the container wrote when it last terminated
+	LastTerminationMessage string `json:"lastTerminationMessage,omitempty"`
+}
+
+// PlannedAction is the action the operator would take on a resource
+type PlannedAction string
+
+const (
+	// PlannedCreate means the resource would be created
+	PlannedCreate PlannedAction = "Create"
+	// PlannedUpdate means the resource would be updated
+	PlannedUpdate PlannedAction = "Update"
+	// PlannedAdopt means the existing resource would be taken over, and updated if fields are listed
+	PlannedAdopt PlannedAction = "Adopt"
+	// PlannedConflict means the existing resource is not owned by the HelidonApp and would be left alone
+	PlannedConflict PlannedAction = "Conflict"
+	// PlannedDelete means the resource would be deleted
+	PlannedDelete PlannedAction = "Delete"
+)
+
+// PlannedChange is a change the operator would apply to a resource of the Helidon application
+// +k8s:openapi-gen=true
+type PlannedChange struct {
+	// Kind of the resource
+	Kind string `json:"kind"`
+	// Name of the resource
+	Name string `json:"name"`
+	// Namespace of the resource, empty for cluster scoped resources
+	Namespace string `json:"namespace,omitempty"`
+	// Action the operator would take
+	Action PlannedAction `json:"action"`
+	// Paths of the fields that would change when the resource is updated
+	// +x-kubernetes-list-type=set
+	Fields []string `json:"fields,omitempty"`
+	// Why the change would be held back: the dependencies that are not Ready, or the pre-deploy hook that has not
+	// succeeded for the new revision
+	HeldBy string `json:"heldBy,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// HelidonApp is the Schema for the helidonapps API
+// +k8s:openapi-gen=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:shortName=ha
+// +kubebuilder:subresource:scale:specpath=.spec.scaling.replicas,statuspath=.status.replicas,selectorpath=.status.selector
+// +kubebuilder:printcolumn:name="Image",type=string,JSONPath=`.spec.container.image`
+// +kubebuilder:printcolumn:name="Ready",type=integer,JSONPath=`.status.readyReplicas`
+// +kubebuilder:printcolumn:name="Desired",type=integer,JSONPath=`.status.replicas`
+// +kubebuilder:printcolumn:name="Status",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`
+// +kubebuilder:printcolumn:name="Schedule",type=string,JSONPath=`.status.activeSchedule`,priority=1
+// +kubebuilder:printcolumn:name="Issue",type=string,JSONPath=`.status.conditions[?(@.type=="Degraded")].reason`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+// +kubebuilder:storageversion
+// +genclient
+// +genclient:method=GetScale,verb=get,subresource=scale,result=k8s.io/api/autoscaling/v1.Scale
+// +genclient:method=UpdateScale,verb=update,subresource=scale,input=k8s.io/api/autoscaling/v1.Scale,result=k8s.io/api/autoscaling/v1.Scale
+type HelidonApp struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   HelidonAppSpec   `json:"spec,omitempty"`
+	Status HelidonAppStatus `json:"status,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// HelidonAppList contains a list of HelidonApp
+type HelidonAppList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []HelidonApp `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&HelidonApp{}, &HelidonAppList{})
+}
@@ -0,0 +1,57 @@
+// Copyright (c) 2020, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// HelidonAppImagePolicySpec defines the images HelidonApps may use
+// +k8s:openapi-gen=true
+type HelidonAppImagePolicySpec struct {
+	// Selects the namespaces the policy applies to, by the labels of the namespace of the HelidonApp or of its
+	// spec.namespace. The policy applies to all namespaces when not set.
+	NamespaceSelector *metav1.LabelSelector `json:"namespaceSelector,omitempty"`
+	// Registries, optionally followed by a repository path, images must come from, for example
+	// container-registry.oracle.com or ghcr.io/myorg. Images without a registry come from docker.io.
+	// Any registry is allowed when empty.
+	// +x-kubernetes-list-type=set
+	AllowedRegistries []string `json:"allowedRegistries,omitempty"`
+	// Tags images must not use. Images without a tag or digest use the latest tag.
+	// +x-kubernetes-list-type=set
+	ForbiddenTags []string `json:"forbiddenTags,omitempty"`
+	// Whether images must be referenced by digest
+	RequireDigest bool `json:"requireDigest,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// HelidonAppImagePolicy restricts the container images of HelidonApps
+// +k8s:openapi-gen=true
+// +kubebuilder:resource:scope=Cluster,shortName=haimagepolicy
+// +kubebuilder:printcolumn:name="Registries",type=string,JSONPath=`.spec.allowedRegistries`
+// +kubebuilder:printcolumn:name="Digest",type=boolean,JSONPath=`.spec.requireDigest`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+// +genclient
+// +genclient:nonNamespaced
+// +genclient:noStatus
+type HelidonAppImagePolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec HelidonAppImagePolicySpec `json:"spec,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// HelidonAppImagePolicyList contains a list of HelidonAppImagePolicy
+type HelidonAppImagePolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []HelidonAppImagePolicy `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&HelidonAppImagePolicy{}, &HelidonAppImagePolicyList{})
+}
@@ -0,0 +1,49 @@
+// Copyright (c) 2020, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// HelidonAppNamespaceGrantSpec defines which HelidonApps may deploy to the namespace of the grant
+// +k8s:openapi-gen=true
+type HelidonAppNamespaceGrantSpec struct {
+	// Namespaces whose HelidonApps may set spec.namespace to the namespace of the grant
+	// +x-kubernetes-list-type=set
+	SourceNamespaces []string `json:"sourceNamespaces"`
+	// ServiceAccounts the granted HelidonApps may run as, in addition to the ServiceAccounts allowed
+	// by the operator
+	// +x-kubernetes-list-type=set
+	ServiceAccountNames []string `json:"serviceAccountNames,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// HelidonAppNamespaceGrant allows HelidonApps in other namespaces to deploy to its namespace
+// +k8s:openapi-gen=true
+// +kubebuilder:resource:shortName=hagrant
+// +kubebuilder:printcolumn:name="Sources",type=string,JSONPath=`.spec.sourceNamespaces`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+// +genclient
+// +genclient:noStatus
+type HelidonAppNamespaceGrant struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec HelidonAppNamespaceGrantSpec `json:"spec,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// HelidonAppNamespaceGrantList contains a list of HelidonAppNamespaceGrant
+type HelidonAppNamespaceGrantList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []HelidonAppNamespaceGrant `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&HelidonAppNamespaceGrant{}, &HelidonAppNamespaceGrantList{})
+}
@@ -0,0 +1,1692 @@
+// +build !ignore_autogenerated
+
+// Copyright (c) 2020, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+// This file was autogenerated by openapi-gen. Do not edit it manually!
+
+package v1
+
+import (
+	spec "github.com/go-openapi/spec"
+	common "k8s.io/kube-openapi/pkg/common"
+)
+
+func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenAPIDefinition {
+	return map[string]common.OpenAPIDefinition{
+		"github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1.BindingServiceReference":      schema_pkg_apis_verrazzano_v1_BindingServiceReference(ref),
+		"github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1.BindingStatus":                schema_pkg_apis_verrazzano_v1_BindingStatus(ref),
+		"github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1.Condition":                    schema_pkg_apis_verrazzano_v1_Condition(ref),
+		"github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1.ContainerSpec":                schema_pkg_apis_verrazzano_v1_ContainerSpec(ref),
+		"github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1.DatabaseSpec":                 schema_pkg_apis_verrazzano_v1_DatabaseSpec(ref),
+		"github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1.DebugSpec":                    schema_pkg_apis_verrazzano_v1_DebugSpec(ref),
+		"github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1.Dependency":                   schema_pkg_apis_verrazzano_v1_Dependency(ref),
+		"github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1.DependencyStatus":             schema_pkg_apis_verrazzano_v1_DependencyStatus(ref),
+		"github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1.HelidonApp":                   schema_pkg_apis_verrazzano_v1_HelidonApp(ref),
+		"github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1.HelidonAppImagePolicy":        schema_pkg_apis_verrazzano_v1_HelidonAppImagePolicy(ref),
+		"github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1.HelidonAppImagePolicySpec":    schema_pkg_apis_verrazzano_v1_HelidonAppImagePolicySpec(ref),
+		"github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1.HelidonAppNamespaceGrant":     schema_pkg_apis_verrazzano_v1_HelidonAppNamespaceGrant(ref),
+		"github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1.HelidonAppNamespaceGrantSpec": schema_pkg_apis_verrazzano_v1_HelidonAppNamespaceGrantSpec(ref),
+		"github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1.HelidonAppSpec":               schema_pkg_apis_verrazzano_v1_HelidonAppSpec(ref),
+		"github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1.HelidonAppStatus":             schema_pkg_apis_verrazzano_v1_HelidonAppStatus(ref),
+		"github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1.HelidonSpec":                  schema_pkg_apis_verrazzano_v1_HelidonSpec(ref),
+		"github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1.HookSpec":                     schema_pkg_apis_verrazzano_v1_HookSpec(ref),
+		"github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1.HookStatus":                   schema_pkg_apis_verrazzano_v1_HookStatus(ref),
+		"github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1.HooksSpec":                    schema_pkg_apis_verrazzano_v1_HooksSpec(ref),
+		"github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1.KnownGoodRevision":            schema_pkg_apis_verrazzano_v1_KnownGoodRevision(ref),
+		"github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1.LoggingSpec":                  schema_pkg_apis_verrazzano_v1_LoggingSpec(ref),
+		"github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1.MetricsSpec":                  schema_pkg_apis_verrazzano_v1_MetricsSpec(ref),
+		"github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1.ObservabilitySpec":            schema_pkg_apis_verrazzano_v1_ObservabilitySpec(ref),
+		"github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1.PlannedChange":                schema_pkg_apis_verrazzano_v1_PlannedChange(ref),
+		"github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1.PodIssue":                     schema_pkg_apis_verrazzano_v1_PodIssue(ref),
+		"github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1.ScalingSpec":                  schema_pkg_apis_verrazzano_v1_ScalingSpec(ref),
+		"github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1.ScheduleEntry":                schema_pkg_apis_verrazzano_v1_ScheduleEntry(ref),
+		"github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1.ServiceBinding":               schema_pkg_apis_verrazzano_v1_ServiceBinding(ref),
+		"github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1.ServiceSpec":                  schema_pkg_apis_verrazzano_v1_ServiceSpec(ref),
+		"github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1.TracingSpec":                  schema_pkg_apis_verrazzano_v1_TracingSpec(ref),
+		"github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1.WalletSpec":                   schema_pkg_apis_verrazzano_v1_WalletSpec(ref),
+	}
+}
+
+func schema_pkg_apis_verrazzano_v1_BindingServiceReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "BindingServiceReference refers to a provisioned service",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The API version of the provisioned service, like example.com/v1",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The kind of the provisioned service",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The name of the provisioned service",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"apiVersion", "kind", "name"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_verrazzano_v1_BindingStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "BindingStatus records the Secret of a binding to a provisioned service",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The name of the binding",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"secret": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The Secret named in status.binding.name of the provisioned service",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name", "secret"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_verrazzano_v1_Condition(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Condition describes the state of the Helidon application at a certain point",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Type of the condition",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Status of the condition, one of True, False or Unknown",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"lastTransitionTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Last time the condition transitioned from one status to another",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"reason": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Machine readable reason for the last transition",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Human readable message for the last transition",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"type", "status"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_pkg_apis_verrazzano_v1_ContainerSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ContainerSpec defines the main Helidon application container",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"image": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The docker image to pull",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"imagePullSecrets": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "The Kubernetes docker secrets for pulling images",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("k8s.io/api/core/v1.LocalObjectReference"),
+									},
+								},
+							},
+						},
+					},
+					"imagePullPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The Kubernetes pull policy for pulling the image",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"env": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Array of environment variables for image",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("k8s.io/api/core/v1.EnvVar"),
+									},
+								},
+							},
+						},
+					},
+					"resources": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Compute resources of the container - defaults to the memory request of spec.runtime",
+							Ref:         ref("k8s.io/api/core/v1.ResourceRequirements"),
+						},
+					},
+				},
+				Required: []string{"image"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/api/core/v1.EnvVar", "k8s.io/api/core/v1.LocalObjectReference", "k8s.io/api/core/v1.ResourceRequirements"},
+	}
+}
+
+func schema_pkg_apis_verrazzano_v1_DatabaseSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DatabaseSpec defines the access of the Helidon application to an Oracle Database",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"wallet": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The wallet of the database, mounted read only in the main container and used by the Oracle JDBC driver",
+							Ref:         ref("github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1.WalletSpec"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1.WalletSpec"},
+	}
+}
+
+func schema_pkg_apis_verrazzano_v1_DebugSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DebugSpec defines the remote debugging of the Helidon application",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"enabled": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Whether the JDWP agent is enabled. While enabled the Deployment runs a single replica without liveness probe.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"port": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The port of the JDWP agent, exposed on the container but not on the Service - defaults to 5005",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"suspend": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Whether the application waits for a debugger to attach before it starts",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"enabled"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_verrazzano_v1_Dependency(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Dependency refers to a HelidonApp the Helidon application depends on",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The name of the HelidonApp",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"namespace": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The namespace of the HelidonApp - defaults to the namespace of this HelidonApp",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"waitFor": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Whether an init container waits for the Service of the dependency to accept connections before the main container starts",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_verrazzano_v1_DependencyStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DependencyStatus records the Service of a dependency",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The name of the HelidonApp",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"namespace": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The namespace of the HelidonApp",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"address": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The host and port of the Service of the dependency",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"ready": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Whether the dependency is Ready",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name", "namespace", "ready"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_verrazzano_v1_HelidonApp(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "HelidonApp is the Schema for the helidonapps API",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1.HelidonAppSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1.HelidonAppStatus"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1.HelidonAppSpec", "github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1.HelidonAppStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_pkg_apis_verrazzano_v1_HelidonAppImagePolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "HelidonAppImagePolicy restricts the container images of HelidonApps",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1.HelidonAppImagePolicySpec"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1.HelidonAppImagePolicySpec", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_pkg_apis_verrazzano_v1_HelidonAppImagePolicySpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "HelidonAppImagePolicySpec defines the images HelidonApps may use",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"namespaceSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Selects the namespaces the policy applies to, by the labels of the namespace of the HelidonApp or of its spec.namespace. The policy applies to all namespaces when not set.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
+						},
+					},
+					"allowedRegistries": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Registries, optionally followed by a repository path, images must come from, for example container-registry.oracle.com or ghcr.io/myorg. Images without a registry come from docker.io. Any registry is allowed when empty.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"forbiddenTags": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Tags images must not use. Images without a tag or digest use the latest tag.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"requireDigest": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Whether images must be referenced by digest",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"},
+	}
+}
+
+func schema_pkg_apis_verrazzano_v1_HelidonAppNamespaceGrant(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "HelidonAppNamespaceGrant allows HelidonApps in other namespaces to deploy to its namespace",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1.HelidonAppNamespaceGrantSpec"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1.HelidonAppNamespaceGrantSpec", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_pkg_apis_verrazzano_v1_HelidonAppNamespaceGrantSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "HelidonAppNamespaceGrantSpec defines which HelidonApps may deploy to the namespace of the grant",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"sourceNamespaces": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Namespaces whose HelidonApps may set spec.namespace to the namespace of the grant",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"serviceAccountNames": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "ServiceAccounts the granted HelidonApps may run as, in addition to the ServiceAccounts allowed by the operator",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"sourceNamespaces"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_verrazzano_v1_HelidonAppSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "HelidonAppSpec defines the desired state of HelidonApp",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"description": {
+						SchemaProps: spec.SchemaProps{
+							Description: "User defined description of the the HelidonApp custom resource",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The name of the Helidon application",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"namespace": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The namespace for the Helidon application",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"serviceAccountName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The Kubernetes ServiceAccount name to run this pod",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"container": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The main Helidon application container",
+							Ref:         ref("github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1.ContainerSpec"),
+						},
+					},
+					"helidon": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The Helidon flavor and version of the application, which drive the defaults of the probes, metrics and configuration. Probes are only generated when set.",
+							Ref:         ref("github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1.HelidonSpec"),
+						},
+					},
+					"runtime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The runtime of the application, jvm or a GraalVM native executable, which drives the defaults of the resources and probes. The defaults only apply when set, and the probe delays only when spec.helidon is set. The native runtime rejects JVM and JMX options.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"debug": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Remote debugging of the main container with the JDWP agent, only supported by the jvm runtime",
+							Ref:         ref("github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1.DebugSpec"),
+						},
+					},
+					"service": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The Service exposing the Helidon application",
+							Ref:         ref("github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1.ServiceSpec"),
+						},
+					},
+					"scaling": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Scaling of the Helidon application",
+							Ref:         ref("github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1.ScalingSpec"),
+						},
+					},
+					"observability": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Observability of the Helidon application",
+							Ref:         ref("github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1.ObservabilitySpec"),
+						},
+					},
+					"tracing": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Distributed tracing of the Helidon application, configured with environment variables of the main container",
+							Ref:         ref("github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1.TracingSpec"),
+						},
+					},
+					"logging": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Log levels and format of the Helidon application, rendered into a ConfigMap mounted in the main container",
+							Ref:         ref("github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1.LoggingSpec"),
+						},
+					},
+					"bindings": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Databases and backing services projected into the main container, following the servicebinding.io specification",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1.ServiceBinding"),
+									},
+								},
+							},
+						},
+					},
+					"database": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Access of the Helidon application to an Oracle Database",
+							Ref:         ref("github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1.DatabaseSpec"),
+						},
+					},
+					"dependsOn": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Other HelidonApps that must be Ready before the Deployment is created or its pods are upgraded",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1.Dependency"),
+									},
+								},
+							},
+						},
+					},
+					"hooks": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Jobs run around the rollouts of the Helidon application",
+							Ref:         ref("github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1.HooksSpec"),
+						},
+					},
+					"initContainers": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "InitContainers holds a list of initialization containers that should be run before starting the main container in this pod.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("k8s.io/api/core/v1.Container"),
+									},
+								},
+							},
+						},
+					},
+					"containers": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Additional containers to be included in the pod",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("k8s.io/api/core/v1.Container"),
+									},
+								},
+							},
+						},
+					},
+					"volumes": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Volumes to be created in the pod",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("k8s.io/api/core/v1.Volume"),
+									},
+								},
+							},
+						},
+					},
+					"restartAt": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Changing the timestamp restarts the pods of the Helidon application with a rolling update",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"suspend": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Scales the Deployment to zero replicas, the Service and the configuration are kept",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"schedule": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Windows overriding the replicas or suspending the Helidon application. The first active window applies.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1.ScheduleEntry"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"description", "name", "namespace", "container"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1.ContainerSpec", "github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1.DatabaseSpec", "github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1.DebugSpec", "github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1.Dependency", "github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1.HelidonSpec", "github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1.HooksSpec", "github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1.LoggingSpec", "github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1.ObservabilitySpec", "github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1.ScalingSpec", "github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1.ScheduleEntry", "github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1.ServiceBinding", "github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1.ServiceSpec", "github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1.TracingSpec", "k8s.io/api/core/v1.Container", "k8s.io/api/core/v1.Volume", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_pkg_apis_verrazzano_v1_HelidonAppStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "HelidonAppStatus defines the observed state of HelidonApp",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"state": {
+						SchemaProps: spec.SchemaProps{
+							Description: "State of the Helidon deployment",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"lastActionMessage": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Message associated with latest action",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"lastActionTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Time stamp for latest action",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"appliedName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of the Deployment and Service last applied for the Helidon application",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"appliedNamespace": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Namespace of the Deployment and Service last applied for the Helidon application",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"replicas": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Number of replicas of the Helidon application Deployment observed by its controller",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"readyReplicas": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Number of ready replicas of the Helidon application Deployment",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"selector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Label selector of the Helidon application pods, used by the scale subresource",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"conditions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Latest observations of the state of the Helidon application",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1.Condition"),
+									},
+								},
+							},
+						},
+					},
+					"plannedChanges": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Changes the operator would apply to the resources of the Helidon application, when in plan mode",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1.PlannedChange"),
+									},
+								},
+							},
+						},
+					},
+					"lastRestartTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The time the operator last restarted the pods because of a change of spec.restartAt",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"activeSchedule": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The name of the active spec.schedule entry",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"nextScheduleTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The time the next spec.schedule window starts or ends",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"podIssues": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The problems of the containers of the pods of the Helidon application",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1.PodIssue"),
+									},
+								},
+							},
+						},
+					},
+					"bindings": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The Secrets of the spec.bindings to provisioned services",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1.BindingStatus"),
+									},
+								},
+							},
+						},
+					},
+					"dependencies": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The Services and readiness of spec.dependsOn",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1.DependencyStatus"),
+									},
+								},
+							},
+						},
+					},
+					"preDeployHook": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The last Job of spec.hooks.preDeploy",
+							Ref:         ref("github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1.HookStatus"),
+						},
+					},
+					"postDeployHook": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The last Job of spec.hooks.postDeploy",
+							Ref:         ref("github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1.HookStatus"),
+						},
+					},
+					"lastKnownGood": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The last revision that passed spec.hooks.postDeploy, restored when the post-deploy Job of a later revision fails",
+							Ref:         ref("github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1.KnownGoodRevision"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1.BindingStatus", "github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1.Condition", "github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1.DependencyStatus", "github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1.HookStatus", "github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1.KnownGoodRevision", "github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1.PlannedChange", "github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1.PodIssue", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_pkg_apis_verrazzano_v1_HelidonSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "HelidonSpec defines the Helidon flavor and version of the application",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"flavor": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The Helidon flavor, SE or MP - defaults to SE",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"version": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The Helidon version, like 2.4.2 or 4 - defaults to 2",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"configProfile": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The configuration profile, set with the environment variable of the flavor and version",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"configMap": {
+						SchemaProps: spec.SchemaProps{
+							Description: "A ConfigMap holding configuration files, mounted at /conf in the main container",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_verrazzano_v1_HookSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "HookSpec defines a Job run by the operator in spec.namespace. Its container gets the environment variables, volumes and image pull secrets of the main container, and runs with the ServiceAccount of the pods.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"image": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The image of the Job - defaults to the image of spec.container",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"command": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The command of the container - defaults to the entrypoint of the image",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"args": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The arguments of the command",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"env": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Environment variables added to the ones of the main container",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("k8s.io/api/core/v1.EnvVar"),
+									},
+								},
+							},
+						},
+					},
+					"backoffLimit": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The number of retries before the Job fails - defaults to 0",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"activeDeadlineSeconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The number of seconds the Job may run before it fails",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/api/core/v1.EnvVar"},
+	}
+}
+
+func schema_pkg_apis_verrazzano_v1_HookStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "HookStatus describes the last Job of a hook",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"job": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The name of the Job, in spec.namespace",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"revision": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The revision of the image and configuration the Job ran for",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"phase": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The phase of the Job, Running, Succeeded or Failed",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"logs": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Where to find the logs of the pods of the Job",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"job", "revision", "phase"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_verrazzano_v1_HooksSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "HooksSpec defines the Jobs run around the rollouts of the Helidon application",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"preDeploy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "A Job run before a new image or configuration is rolled out, like a database schema migration. The Deployment is only created or updated once it succeeded.",
+							Ref:         ref("github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1.HookSpec"),
+						},
+					},
+					"postDeploy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "A Job run once a new image or configuration is fully available, like smoke tests of the endpoints of the new pods. When it fails, the rollback policy applies.",
+							Ref:         ref("github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1.HookSpec"),
+						},
+					},
+					"rollbackPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "What the operator does when the post-deploy Job fails, Automatic restores the last known good pod template and None leaves the pods as they are - defaults to Automatic",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"historyLimit": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The number of completed hook Jobs kept, the Job of the current image and configuration is always kept - defaults to 3",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1.HookSpec"},
+	}
+}
+
+func schema_pkg_apis_verrazzano_v1_KnownGoodRevision(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "KnownGoodRevision is a pod template that was fully available and passed the post-deploy Job",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"revision": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The revision of the image and configuration",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"template": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The pod template of the Deployment",
+							Ref:         ref("k8s.io/apimachinery/pkg/runtime.RawExtension"),
+						},
+					},
+				},
+				Required: []string{"revision", "template"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/runtime.RawExtension"},
+	}
+}
+
+func schema_pkg_apis_verrazzano_v1_LoggingSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "LoggingSpec defines the log levels and format of the Helidon application",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"level": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The level of the root logger - defaults to INFO",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"levels": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The levels of loggers by package or class name, like io.helidon.webserver: DEBUG",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"format": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The format of the log records, plain or json - defaults to plain. The json format needs the log4j2 framework and the org.apache.logging.log4j:log4j-layout-template-json module, 2.14 or later, in the application.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"framework": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The logging framework of the application, jul or log4j2 - defaults to jul",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"inPlace": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Whether Log4j 2 reloads the logging configuration when it changes, instead of restarting the pods. Needs the log4j2 framework.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_verrazzano_v1_MetricsSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MetricsSpec defines how Prometheus scrapes the Helidon application",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"enabled": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Whether Prometheus scrapes the application - defaults to true",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"path": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Path of the metrics endpoint - defaults to the metrics path of the Helidon version, /metrics before Helidon 4",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_verrazzano_v1_ObservabilitySpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ObservabilitySpec defines how the Helidon application is observed",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"metrics": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Prometheus metrics scraping",
+							Ref:         ref("github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1.MetricsSpec"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1.MetricsSpec"},
+	}
+}
+
+func schema_pkg_apis_verrazzano_v1_PlannedChange(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PlannedChange is a change the operator would apply to a resource of the Helidon application",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind of the resource",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of the resource",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"namespace": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Namespace of the resource, empty for cluster scoped resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"action": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Action the operator would take",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"fields": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Paths of the fields that would change when the resource is updated",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"heldBy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Why the change would be held back: the dependencies that are not Ready, or the pre-deploy hook that has not succeeded for the new revision",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"kind", "name", "action"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_verrazzano_v1_PodIssue(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PodIssue describes the problem of a pod, or of one of its containers",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"pod": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The name of the pod",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"container": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The name of the container, empty for problems of the pod itself",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"reason": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The reason of the problem, like ImagePullBackOff, CrashLoopBackOff or Unschedulable",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The message of the problem",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"restartCount": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The number of times the container restarted",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"lastTerminationReason": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The reason the container last terminated, like OOMKilled",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"lastTerminationMessage": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The message the container wrote when it last terminated",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"pod", "reason"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_verrazzano_v1_ScalingSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ScalingSpec defines the scaling of the Helidon application",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"replicas": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Number of replicas to create. This is a pointer to distinguish between explicit zero and not specified. Defaults to 1.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_verrazzano_v1_ScheduleEntry(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ScheduleEntry defines a window, from a start to an end given as cron expressions, during which the replicas of the Helidon application are overridden",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The name of the entry, shown in the status while the window is active",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"start": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The cron expression of the start of the window, for example \"0 20 * * 1-5\"",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"end": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The cron expression of the end of the window, for example \"0 7 * * 1-5\"",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"timeZone": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The IANA time zone of the cron expressions, defaults to UTC",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"replicas": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The replicas during the window",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"suspend": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Scales the Deployment to zero replicas during the window",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name", "start", "end"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_verrazzano_v1_ServiceBinding(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ServiceBinding defines a binding projected into the main container at /bindings/<name>. The binding is read from a Secret, or from the Secret named in status.binding.name of a provisioned service.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The name of the binding, the directory under /bindings",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"secret": {
+						SchemaProps: spec.SchemaProps{
+							Description: "A Secret in spec.namespace holding the binding",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"service": {
+						SchemaProps: spec.SchemaProps{
+							Description: "A provisioned service in spec.namespace, whose status.binding.name is the Secret holding the binding",
+							Ref:         ref("github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1.BindingServiceReference"),
+						},
+					},
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The type of the binding, like oracle, postgresql, mysql or kafka. It selects the well-known keys mapped to configuration.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"env": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Whether the well-known keys of the type are mapped to environment variables of the Helidon configuration, like the javax.sql.DataSource properties for databases",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1.BindingServiceReference"},
+	}
+}
+
+func schema_pkg_apis_verrazzano_v1_ServiceSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ServiceSpec defines the Service exposing the Helidon application",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"port": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Port to be used for service and container - defaults to 8080",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"targetPort": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Port to be used for service targetPort - defaults to port",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_verrazzano_v1_TracingSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "TracingSpec defines the distributed tracing of the Helidon application",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"enabled": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Whether the application sends spans",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"provider": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The tracing system, opentelemetry, jaeger or zipkin - defaults to opentelemetry",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"endpoint": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The URL of the collector, like http://jaeger-collector.monitoring:14268/api/traces - defaults to the operator wide endpoint",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"samplingRate": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The fraction of the traces sampled, between 0 and 1 like \"0.1\" - defaults to the sampler of the provider",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"serviceName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The service name of the spans - defaults to spec.name",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"enabled"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_verrazzano_v1_WalletSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "WalletSpec defines an Oracle wallet, like the wallet of an Autonomous Database",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"secret": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The Secret in spec.namespace holding the wallet files, at least cwallet.sso and tnsnames.ora",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"mountPath": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The directory of the wallet in the main container - defaults to /wallet",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"secret"},
+			},
+		},
+	}
+}
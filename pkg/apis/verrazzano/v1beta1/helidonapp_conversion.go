@@ -0,0 +1,136 @@
+// Copyright (c) 2020, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package v1beta1
+
+import (
+	"encoding/json"
+
+	v1 "github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+)
+
+// V1SpecAnnotation holds the parts of a v1 spec that can't be represented in v1beta1, so that
+// converting v1 to v1beta1 and back is lossless
+const V1SpecAnnotation = "helidonapp.verrazzano.io/v1-spec"
+
+// ConvertTo converts this HelidonApp to the hub version (v1)
+func (src *HelidonApp) ConvertTo(dstRaw conversion.Hub) error {
+	dst := dstRaw.(*v1.HelidonApp)
+
+	dst.ObjectMeta = *src.ObjectMeta.DeepCopy()
+	// Start from the v1 only fields saved when the object was converted from v1
+	dst.Spec = v1.HelidonAppSpec{}
+	if saved, ok := src.Annotations[V1SpecAnnotation]; ok {
+		if err := json.Unmarshal([]byte(saved), &dst.Spec); err != nil {
+			return err
+		}
+		delete(dst.Annotations, V1SpecAnnotation)
+		if len(dst.Annotations) == 0 {
+			dst.Annotations = nil
+		}
+	}
+	convertSpecToV1(src.Spec.DeepCopy(), &dst.Spec)
+
+	dst.Status = v1.HelidonAppStatus{
+		State:             src.Status.State,
+		LastActionMessage: src.Status.LastActionMessage,
+		LastActionTime:    src.Status.LastActionTime,
+		AppliedName:       src.Status.AppliedName,
+		AppliedNamespace:  src.Status.AppliedNamespace,
+		Replicas:          src.Status.Replicas,
+		ReadyReplicas:     src.Status.ReadyReplicas,
+		Selector:          src.Status.Selector,
+	}
+	for _, c := range src.Status.Conditions {
+		dst.Status.Conditions = append(dst.Status.Conditions, v1.Condition{
+			Type:               v1.ConditionType(c.Type),
+			Status:             c.Status,
+			LastTransitionTime: c.LastTransitionTime,
+			Reason:             c.Reason,
+			Message:            c.Message,
+		})
+	}
+	return nil
+}
+
+// ConvertFrom converts from the hub version (v1) to this version
+func (dst *HelidonApp) ConvertFrom(srcRaw conversion.Hub) error {
+	src := srcRaw.(*v1.HelidonApp)
+
+	dst.ObjectMeta = *src.ObjectMeta.DeepCopy()
+	spec := src.Spec.DeepCopy()
+	dst.Spec = HelidonAppSpec{
+		Description:        spec.Description,
+		Name:               spec.Name,
+		Namespace:          spec.Namespace,
+		Image:              spec.Container.Image,
+		ImagePullSecrets:   spec.Container.ImagePullSecrets,
+		ImagePullPolicy:    spec.Container.ImagePullPolicy,
+		ServiceAccountName: spec.ServiceAccountName,
+		Replicas:           spec.Scaling.Replicas,
+		Port:               spec.Service.Port,
+		TargetPort:         spec.Service.TargetPort,
+		Env:                spec.Container.Env,
+		InitContainers:     spec.InitContainers,
+		Containers:         spec.Containers,
+		Volumes:            spec.Volumes,
+	}
+
+	// Save the v1 spec if it holds anything v1beta1 can't represent. Empty and unset collections are the same
+	// once serialized, so they are compared as equal.
+	roundTrip := v1.HelidonAppSpec{}
+	convertSpecToV1(&dst.Spec, &roundTrip)
+	if !equality.Semantic.DeepEqual(roundTrip, src.Spec) {
+		saved, err := json.Marshal(src.Spec)
+		if err != nil {
+			return err
+		}
+		if dst.Annotations == nil {
+			dst.Annotations = make(map[string]string)
+		}
+		dst.Annotations[V1SpecAnnotation] = string(saved)
+	}
+
+	// Status fields that only exist in v1 are not converted, the status is only written by the operator,
+	// through v1
+	dst.Status = HelidonAppStatus{
+		State:             src.Status.State,
+		LastActionMessage: src.Status.LastActionMessage,
+		LastActionTime:    src.Status.LastActionTime,
+		AppliedName:       src.Status.AppliedName,
+		AppliedNamespace:  src.Status.AppliedNamespace,
+		Replicas:          src.Status.Replicas,
+		ReadyReplicas:     src.Status.ReadyReplicas,
+		Selector:          src.Status.Selector,
+	}
+	for _, c := range src.Status.Conditions {
+		dst.Status.Conditions = append(dst.Status.Conditions, Condition{
+			Type:               ConditionType(c.Type),
+			Status:             c.Status,
+			LastTransitionTime: c.LastTransitionTime,
+			Reason:             c.Reason,
+			Message:            c.Message,
+		})
+	}
+	return nil
+}
+
+// convertSpecToV1 sets the fields of the v1 spec that have a v1beta1 equivalent
+func convertSpecToV1(src *HelidonAppSpec, dst *v1.HelidonAppSpec) {
+	dst.Description = src.Description
+	dst.Name = src.Name
+	dst.Namespace = src.Namespace
+	dst.ServiceAccountName = src.ServiceAccountName
+	dst.Container.Image = src.Image
+	dst.Container.ImagePullSecrets = src.ImagePullSecrets
+	dst.Container.ImagePullPolicy = src.ImagePullPolicy
+	dst.Container.Env = src.Env
+	dst.Service.Port = src.Port
+	dst.Service.TargetPort = src.TargetPort
+	dst.Scaling.Replicas = src.Replicas
+	dst.InitContainers = src.InitContainers
+	dst.Containers = src.Containers
+	dst.Volumes = src.Volumes
+}
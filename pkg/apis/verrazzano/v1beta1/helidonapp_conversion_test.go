@@ -0,0 +1,120 @@
+// Copyright (c) 2020, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package v1beta1
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	v1 "github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Test that converting v1beta1 to v1 and back is lossless
+func TestConvertRoundTripFromV1beta1(t *testing.T) {
+	var replicas int32 = 3
+	src := &HelidonApp{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "myapp",
+			Namespace:   "myns",
+			Labels:      map[string]string{"label": "value"},
+			Annotations: map[string]string{"annotation": "value"},
+		},
+		Spec: HelidonAppSpec{
+			Description:        "my app",
+			Name:               "myapp",
+			Namespace:          "myns",
+			Image:              "myimage",
+			ImagePullSecrets:   []corev1.LocalObjectReference{{Name: "secret"}},
+			ImagePullPolicy:    corev1.PullAlways,
+			ServiceAccountName: "mysa",
+			Replicas:           &replicas,
+			Port:               8010,
+			TargetPort:         8011,
+			Env:                []corev1.EnvVar{{Name: "key", Value: "value"}},
+			InitContainers:     []corev1.Container{{Name: "init", Image: "init-image"}},
+			Containers:         []corev1.Container{{Name: "sidecar", Image: "sidecar-image"}},
+			Volumes:            []corev1.Volume{{Name: "volume"}},
+		},
+		Status: HelidonAppStatus{
+			State:             "Deployed",
+			LastActionMessage: "message",
+			LastActionTime:    "2020-01-01T00:00:00Z",
+			AppliedName:       "myapp",
+			AppliedNamespace:  "myns",
+			Replicas:          3,
+			ReadyReplicas:     2,
+			Selector:          "app=myapp",
+			Conditions: []Condition{{
+				Type:               ConditionReady,
+				Status:             corev1.ConditionFalse,
+				LastTransitionTime: metav1.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+				Reason:             "DeploymentNotReady",
+				Message:            "message",
+			}},
+		},
+	}
+
+	hub := &v1.HelidonApp{}
+	assert.NoError(t, src.ConvertTo(hub))
+	assert.Equal(t, "myimage", hub.Spec.Container.Image)
+	assert.Equal(t, int32(8010), hub.Spec.Service.Port)
+	assert.Equal(t, int32(8011), hub.Spec.Service.TargetPort)
+	assert.Equal(t, replicas, *hub.Spec.Scaling.Replicas)
+
+	dst := &HelidonApp{}
+	assert.NoError(t, dst.ConvertFrom(hub))
+	assert.Equal(t, src, dst, "Expected lossless round trip")
+}
+
+// Test that v1 only fields survive a conversion to v1beta1 and back
+func TestConvertRoundTripFromV1(t *testing.T) {
+	enabled := false
+	src := &v1.HelidonApp{
+		ObjectMeta: metav1.ObjectMeta{Name: "myapp", Namespace: "myns"},
+	}
+	src.Spec.Name = "myapp"
+	src.Spec.Namespace = "myns"
+	src.Spec.Container.Image = "myimage"
+	src.Spec.Observability.Metrics.Enabled = &enabled
+	src.Spec.Observability.Metrics.Path = "/observe/metrics"
+
+	spoke := &HelidonApp{}
+	assert.NoError(t, spoke.ConvertFrom(src))
+	assert.Contains(t, spoke.Annotations, V1SpecAnnotation, "Expected v1 spec to be saved")
+
+	// Changes made through v1beta1 win over the saved spec
+	spoke.Spec.Image = "newimage"
+	dst := &v1.HelidonApp{}
+	assert.NoError(t, spoke.ConvertTo(dst))
+	assert.Nil(t, dst.Annotations, "Expected saved v1 spec annotation to be removed")
+	assert.Equal(t, "newimage", dst.Spec.Container.Image)
+	assert.Equal(t, src.Spec.Observability, dst.Spec.Observability)
+
+	// Nothing is saved if v1beta1 can represent the spec
+	src.Spec.Observability = v1.ObservabilitySpec{}
+	spoke = &HelidonApp{}
+	assert.NoError(t, spoke.ConvertFrom(src))
+	assert.NotContains(t, spoke.Annotations, V1SpecAnnotation)
+}
+
+// Test that empty collections, which v1beta1 can't tell from unset ones, don't save the v1 spec
+func TestConvertFromV1EmptyCollections(t *testing.T) {
+	src := &v1.HelidonApp{
+		ObjectMeta: metav1.ObjectMeta{Name: "myapp", Namespace: "myns"},
+	}
+	src.Spec.Name = "myapp"
+	src.Spec.Namespace = "myns"
+	src.Spec.Container.Image = "myimage"
+	src.Spec.Container.Env = []corev1.EnvVar{}
+	src.Spec.Volumes = []corev1.Volume{}
+	src.Spec.DependsOn = []v1.Dependency{}
+	src.Spec.Schedule = []v1.ScheduleEntry{}
+
+	spoke := &HelidonApp{}
+	assert.NoError(t, spoke.ConvertFrom(src))
+	assert.NotContains(t, spoke.Annotations, V1SpecAnnotation)
+}
@@ -66,6 +66,43 @@ type HelidonAppStatus struct {
 	LastActionMessage string `json:"lastActionMessage,omitempty"`
 	// Time stamp for latest action
 	LastActionTime string `json:"lastActionTime,omitempty"`
+	// Name of the Deployment and Service last applied for the Helidon application
+	AppliedName string `json:"appliedName,omitempty"`
+	// Namespace of the Deployment and Service last applied for the Helidon application
+	AppliedNamespace string `json:"appliedNamespace,omitempty"`
+	// Number of replicas of the Helidon application Deployment observed by its controller
+	Replicas int32 `json:"replicas,omitempty"`
+	// Number of ready replicas of the Helidon application Deployment
+	ReadyReplicas int32 `json:"readyReplicas,omitempty"`
+	// Label selector of the Helidon application pods, used by the scale subresource
+	Selector string `json:"selector,omitempty"`
+	// Latest observations of the state of the Helidon application
+	// +x-kubernetes-list-type=map
+	// +x-kubernetes-list-map-keys=type
+	Conditions []Condition `json:"conditions,omitempty"`
+}
+
+// ConditionType is the type of a HelidonApp condition
+type ConditionType string
+
+const (
+	// ConditionReady indicates all the desired replicas of the Helidon application are available
+	ConditionReady ConditionType = "Ready"
+)
+
+// Condition describes the state of the Helidon application at a certain point
+// +k8s:openapi-gen=true
+type Condition struct {
+	// Type of the condition
+	Type ConditionType `json:"type"`
+	// Status of the condition, one of True, False or Unknown
+	Status corev1.ConditionStatus `json:"status"`
+	// Last time the condition transitioned from one status to another
+	LastTransitionTime metav1.Time `json:"lastTransitionTime,omitempty"`
+	// Machine readable reason for the last transition
+	Reason string `json:"reason,omitempty"`
+	// Human readable message for the last transition
+	Message string `json:"message,omitempty"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -74,8 +111,15 @@ type HelidonAppStatus struct {
 // +k8s:openapi-gen=true
 // +kubebuilder:subresource:status
 // +kubebuilder:resource:shortName=ha
+// +kubebuilder:subresource:scale:specpath=.spec.replicas,statuspath=.status.replicas,selectorpath=.status.selector
+// +kubebuilder:printcolumn:name="Image",type=string,JSONPath=`.spec.image`
+// +kubebuilder:printcolumn:name="Ready",type=integer,JSONPath=`.status.readyReplicas`
+// +kubebuilder:printcolumn:name="Desired",type=integer,JSONPath=`.status.replicas`
+// +kubebuilder:printcolumn:name="Status",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
 // +genclient
-// +genclient:noStatus
+// +genclient:method=GetScale,verb=get,subresource=scale,result=k8s.io/api/autoscaling/v1.Scale
+// +genclient:method=UpdateScale,verb=update,subresource=scale,input=k8s.io/api/autoscaling/v1.Scale,result=k8s.io/api/autoscaling/v1.Scale
 type HelidonApp struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
@@ -14,12 +14,63 @@ import (
 
 func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenAPIDefinition {
 	return map[string]common.OpenAPIDefinition{
+		"github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1beta1.Condition":        schema_pkg_apis_verrazzano_v1beta1_Condition(ref),
 		"github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1beta1.HelidonApp":       schema_pkg_apis_verrazzano_v1beta1_HelidonApp(ref),
 		"github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1beta1.HelidonAppSpec":   schema_pkg_apis_verrazzano_v1beta1_HelidonAppSpec(ref),
 		"github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1beta1.HelidonAppStatus": schema_pkg_apis_verrazzano_v1beta1_HelidonAppStatus(ref),
 	}
 }
 
+func schema_pkg_apis_verrazzano_v1beta1_Condition(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Condition describes the state of the Helidon application at a certain point",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Type of the condition",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Status of the condition, one of True, False or Unknown",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"lastTransitionTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Last time the condition transitioned from one status to another",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"reason": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Machine readable reason for the last transition",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Human readable message for the last transition",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"type", "status"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
 func schema_pkg_apis_verrazzano_v1beta1_HelidonApp(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -261,8 +312,58 @@ func schema_pkg_apis_verrazzano_v1beta1_HelidonAppStatus(ref common.ReferenceCal
 							Format:      "",
 						},
 					},
+					"appliedName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of the Deployment and Service last applied for the Helidon application",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"appliedNamespace": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Namespace of the Deployment and Service last applied for the Helidon application",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"replicas": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Number of replicas of the Helidon application Deployment observed by its controller",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"readyReplicas": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Number of ready replicas of the Helidon application Deployment",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"selector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Label selector of the Helidon application pods, used by the scale subresource",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"conditions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Latest observations of the state of the Helidon application",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1beta1.Condition"),
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
+		Dependencies: []string{
+			"github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1beta1.Condition"},
 	}
 }
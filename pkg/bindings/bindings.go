@@ -0,0 +1,122 @@
+// Copyright (c) 2020, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+// Package bindings projects the spec.bindings of HelidonApps into the main container following the
+// servicebinding.io specification, and maps the well-known keys of the binding types to the Helidon configuration.
+package bindings
+
+import (
+	"fmt"
+	"strings"
+
+	verrazzanov1 "github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1"
+	"github.com/verrazzano/verrazzano-helidon-app-operator/pkg/helidon"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+const (
+	// Root is the directory the bindings are mounted under
+	Root = "/bindings"
+	// RootEnv is the environment variable giving the application the directory of the bindings
+	RootEnv = "SERVICE_BINDING_ROOT"
+)
+
+// database holds the DataSource class and the JDBC URL format, given the host, port and database, of a database
+// binding type
+type database struct {
+	dataSourceClassName string
+	urlFormat           string
+}
+
+// databases are the database binding types mapped to javax.sql.DataSource properties
+var databases = map[string]database{
+	"oracle":     {"oracle.jdbc.pool.OracleDataSource", "jdbc:oracle:thin:@//%s:%s/%s"},
+	"postgresql": {"org.postgresql.ds.PGSimpleDataSource", "jdbc:postgresql://%s:%s/%s"},
+	"mysql":      {"com.mysql.cj.jdbc.MysqlDataSource", "jdbc:mysql://%s:%s/%s"},
+}
+
+// kafkaBootstrapServers is the configuration of the bootstrap servers of the Helidon Kafka connector
+const kafkaBootstrapServers = "mp.messaging.connector.helidon-kafka.bootstrap.servers"
+
+// MountPath returns the directory of a binding in the main container
+func MountPath(binding verrazzanov1.ServiceBinding) string {
+	return Root + "/" + binding.Name
+}
+
+// SecretName returns the Secret holding a binding, the one of the provisioned service recorded in the status
+// for bindings to a provisioned service. It is empty if the provisioned service was not resolved yet.
+func SecretName(cr *verrazzanov1.HelidonApp, binding verrazzanov1.ServiceBinding) string {
+	if binding.Secret != "" {
+		return binding.Secret
+	}
+	for _, status := range cr.Status.Bindings {
+		if status.Name == binding.Name {
+			return status.Secret
+		}
+	}
+	return ""
+}
+
+// Env returns the environment variables mapping the well-known keys of the binding type to the Helidon
+// configuration. Entries missing from the Secret are optional, Kubernetes leaves the variables unset.
+func Env(cr *verrazzanov1.HelidonApp, binding verrazzanov1.ServiceBinding) []corev1.EnvVar {
+	secret := SecretName(cr, binding)
+	if !binding.Env || secret == "" {
+		return nil
+	}
+	optional := true
+	fromSecret := func(name string, key string) corev1.EnvVar {
+		return corev1.EnvVar{Name: name, ValueFrom: &corev1.EnvVarSource{SecretKeyRef: &corev1.SecretKeySelector{
+			LocalObjectReference: corev1.LocalObjectReference{Name: secret},
+			Key:                  key,
+			Optional:             &optional,
+		}}}
+	}
+	config := func(key string) string {
+		return helidon.ConfigEnv(cr, key)
+	}
+
+	if db, ok := databases[binding.Type]; ok {
+		// The JDBC URL is composed from the host, port and database entries, with dependent variables
+		prefix := "BINDING_" + strings.ToUpper(strings.ReplaceAll(binding.Name, "-", "_")) + "_"
+		property := "javax.sql.DataSource." + binding.Name + "."
+		return []corev1.EnvVar{
+			fromSecret(prefix+"HOST", "host"),
+			fromSecret(prefix+"PORT", "port"),
+			fromSecret(prefix+"DATABASE", "database"),
+			{Name: config(property + "dataSourceClassName"), Value: db.dataSourceClassName},
+			{Name: config(property + "dataSource.url"), Value: fmt.Sprintf(db.urlFormat, "$("+prefix+"HOST)", "$("+prefix+"PORT)", "$("+prefix+"DATABASE)")},
+			fromSecret(config(property+"dataSource.user"), "username"),
+			fromSecret(config(property+"dataSource.password"), "password"),
+		}
+	}
+	if binding.Type == "kafka" {
+		return []corev1.EnvVar{fromSecret(config(kafkaBootstrapServers), "bootstrap-servers")}
+	}
+	return nil
+}
+
+// Validate checks the spec.bindings of the HelidonApp
+func Validate(cr *verrazzanov1.HelidonApp) error {
+	names := make(map[string]bool)
+	for _, binding := range cr.Spec.Bindings {
+		if errs := validation.IsDNS1123Label(binding.Name); len(errs) > 0 {
+			return fmt.Errorf("invalid binding name %q: %s", binding.Name, strings.Join(errs, ", "))
+		}
+		if names[binding.Name] {
+			return fmt.Errorf("duplicate binding %s", binding.Name)
+		}
+		names[binding.Name] = true
+		if (binding.Secret == "") == (binding.Service == nil) {
+			return fmt.Errorf("binding %s must refer to either a Secret or a provisioned service", binding.Name)
+		}
+		if service := binding.Service; service != nil && (service.APIVersion == "" || service.Kind == "" || service.Name == "") {
+			return fmt.Errorf("binding %s must give the apiVersion, kind and name of the provisioned service", binding.Name)
+		}
+		if _, ok := databases[binding.Type]; binding.Env && !ok && binding.Type != "kafka" {
+			return fmt.Errorf("binding %s can't map type %q to environment variables, expected oracle, postgresql, mysql or kafka", binding.Name, binding.Type)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,89 @@
+// Copyright (c) 2020, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package bindings
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	verrazzanov1 "github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// Test the Secret of bindings to Secrets and to provisioned services
+func TestSecretName(t *testing.T) {
+	cr := &verrazzanov1.HelidonApp{}
+	assert.Equal(t, "orders-db", SecretName(cr, verrazzanov1.ServiceBinding{Name: "db", Secret: "orders-db"}))
+	binding := verrazzanov1.ServiceBinding{Name: "db", Service: &verrazzanov1.BindingServiceReference{APIVersion: "example.com/v1", Kind: "Database", Name: "orders"}}
+	assert.Equal(t, "", SecretName(cr, binding))
+	cr.Status.Bindings = []verrazzanov1.BindingStatus{{Name: "db", Secret: "orders-binding"}}
+	assert.Equal(t, "orders-binding", SecretName(cr, binding))
+	assert.Equal(t, "/bindings/db", MountPath(binding))
+}
+
+// Test mapping a database binding to the DataSource properties of Helidon SE and MP
+func TestEnvDatabase(t *testing.T) {
+	cr := &verrazzanov1.HelidonApp{}
+	binding := verrazzanov1.ServiceBinding{Name: "orders-db", Secret: "orders-db", Type: "postgresql"}
+	assert.Empty(t, Env(cr, binding), "Expected no variables without env")
+
+	binding.Env = true
+	env := Env(cr, binding)
+	if assert.Len(t, env, 7) {
+		assert.Equal(t, "BINDING_ORDERS_DB_HOST", env[0].Name)
+		assert.Equal(t, "host", env[0].ValueFrom.SecretKeyRef.Key)
+		assert.True(t, *env[0].ValueFrom.SecretKeyRef.Optional)
+		assert.Equal(t, corev1.EnvVar{Name: "javax_sql_DataSource_orders_dash_db_dataSourceClassName", Value: "org.postgresql.ds.PGSimpleDataSource"}, env[3])
+		assert.Equal(t, corev1.EnvVar{Name: "javax_sql_DataSource_orders_dash_db_dataSource_url",
+			Value: "jdbc:postgresql://$(BINDING_ORDERS_DB_HOST):$(BINDING_ORDERS_DB_PORT)/$(BINDING_ORDERS_DB_DATABASE)"}, env[4])
+		assert.Equal(t, "javax_sql_DataSource_orders_dash_db_dataSource_user", env[5].Name)
+		assert.Equal(t, "username", env[5].ValueFrom.SecretKeyRef.Key)
+		assert.Equal(t, "orders-db", env[6].ValueFrom.SecretKeyRef.Name)
+	}
+
+	cr.Spec.Helidon = &verrazzanov1.HelidonSpec{Flavor: verrazzanov1.HelidonFlavorMP}
+	binding.Type = "oracle"
+	env = Env(cr, binding)
+	assert.Equal(t, corev1.EnvVar{Name: "JAVAX_SQL_DATASOURCE_ORDERS_DB_DATASOURCE_URL",
+		Value: "jdbc:oracle:thin:@//$(BINDING_ORDERS_DB_HOST):$(BINDING_ORDERS_DB_PORT)/$(BINDING_ORDERS_DB_DATABASE)"}, env[4])
+}
+
+// Test mapping a Kafka binding to the bootstrap servers of the Helidon Kafka connector
+func TestEnvKafka(t *testing.T) {
+	cr := &verrazzanov1.HelidonApp{}
+	cr.Spec.Helidon = &verrazzanov1.HelidonSpec{Flavor: verrazzanov1.HelidonFlavorMP}
+	env := Env(cr, verrazzanov1.ServiceBinding{Name: "events", Secret: "kafka", Type: "kafka", Env: true})
+	if assert.Len(t, env, 1) {
+		assert.Equal(t, "MP_MESSAGING_CONNECTOR_HELIDON_KAFKA_BOOTSTRAP_SERVERS", env[0].Name)
+		assert.Equal(t, "bootstrap-servers", env[0].ValueFrom.SecretKeyRef.Key)
+	}
+}
+
+// Test the validation of spec.bindings
+func TestValidate(t *testing.T) {
+	service := &verrazzanov1.BindingServiceReference{APIVersion: "example.com/v1", Kind: "Database", Name: "orders"}
+	tests := []struct {
+		bindings []verrazzanov1.ServiceBinding
+		message  string
+	}{
+		{[]verrazzanov1.ServiceBinding{{Name: "Orders", Secret: "db"}}, `invalid binding name "Orders"`},
+		{[]verrazzanov1.ServiceBinding{{Name: "db", Secret: "db"}, {Name: "db", Secret: "other"}}, "duplicate binding db"},
+		{[]verrazzanov1.ServiceBinding{{Name: "db"}}, "binding db must refer to either a Secret or a provisioned service"},
+		{[]verrazzanov1.ServiceBinding{{Name: "db", Secret: "db", Service: service}}, "binding db must refer to either a Secret or a provisioned service"},
+		{[]verrazzanov1.ServiceBinding{{Name: "db", Service: &verrazzanov1.BindingServiceReference{Name: "orders"}}}, "binding db must give the apiVersion, kind and name of the provisioned service"},
+		{[]verrazzanov1.ServiceBinding{{Name: "db", Secret: "db", Env: true}}, `binding db can't map type "" to environment variables, expected oracle, postgresql, mysql or kafka`},
+	}
+	for _, test := range tests {
+		cr := &verrazzanov1.HelidonApp{}
+		cr.Spec.Bindings = test.bindings
+		err := Validate(cr)
+		if assert.Error(t, err) {
+			assert.Contains(t, err.Error(), test.message)
+		}
+	}
+
+	cr := &verrazzanov1.HelidonApp{}
+	cr.Spec.Bindings = []verrazzanov1.ServiceBinding{{Name: "db", Service: service, Type: "mysql", Env: true}, {Name: "cache", Secret: "coherence"}}
+	assert.NoError(t, Validate(cr))
+}
@@ -8,6 +8,7 @@ package versioned
 import (
 	"fmt"
 
+	verrazzanov1 "github.com/verrazzano/verrazzano-helidon-app-operator/pkg/client/clientset/versioned/typed/verrazzano/v1"
 	verrazzanov1beta1 "github.com/verrazzano/verrazzano-helidon-app-operator/pkg/client/clientset/versioned/typed/verrazzano/v1beta1"
 	discovery "k8s.io/client-go/discovery"
 	rest "k8s.io/client-go/rest"
@@ -17,6 +18,7 @@ import (
 type Interface interface {
 	Discovery() discovery.DiscoveryInterface
 	VerrazzanoV1beta1() verrazzanov1beta1.VerrazzanoV1beta1Interface
+	VerrazzanoV1() verrazzanov1.VerrazzanoV1Interface
 }
 
 // Clientset contains the clients for groups. Each group has exactly one
@@ -24,6 +26,7 @@ type Interface interface {
 type Clientset struct {
 	*discovery.DiscoveryClient
 	verrazzanoV1beta1 *verrazzanov1beta1.VerrazzanoV1beta1Client
+	verrazzanoV1      *verrazzanov1.VerrazzanoV1Client
 }
 
 // VerrazzanoV1beta1 retrieves the VerrazzanoV1beta1Client
@@ -31,6 +34,11 @@ func (c *Clientset) VerrazzanoV1beta1() verrazzanov1beta1.VerrazzanoV1beta1Inter
 	return c.verrazzanoV1beta1
 }
 
+// VerrazzanoV1 retrieves the VerrazzanoV1Client
+func (c *Clientset) VerrazzanoV1() verrazzanov1.VerrazzanoV1Interface {
+	return c.verrazzanoV1
+}
+
 // Discovery retrieves the DiscoveryClient
 func (c *Clientset) Discovery() discovery.DiscoveryInterface {
 	if c == nil {
@@ -56,6 +64,10 @@ func NewForConfig(c *rest.Config) (*Clientset, error) {
 	if err != nil {
 		return nil, err
 	}
+	cs.verrazzanoV1, err = verrazzanov1.NewForConfig(&configShallowCopy)
+	if err != nil {
+		return nil, err
+	}
 
 	cs.DiscoveryClient, err = discovery.NewDiscoveryClientForConfig(&configShallowCopy)
 	if err != nil {
@@ -69,6 +81,7 @@ func NewForConfig(c *rest.Config) (*Clientset, error) {
 func NewForConfigOrDie(c *rest.Config) *Clientset {
 	var cs Clientset
 	cs.verrazzanoV1beta1 = verrazzanov1beta1.NewForConfigOrDie(c)
+	cs.verrazzanoV1 = verrazzanov1.NewForConfigOrDie(c)
 
 	cs.DiscoveryClient = discovery.NewDiscoveryClientForConfigOrDie(c)
 	return &cs
@@ -78,6 +91,7 @@ func NewForConfigOrDie(c *rest.Config) *Clientset {
 func New(c rest.Interface) *Clientset {
 	var cs Clientset
 	cs.verrazzanoV1beta1 = verrazzanov1beta1.New(c)
+	cs.verrazzanoV1 = verrazzanov1.New(c)
 
 	cs.DiscoveryClient = discovery.NewDiscoveryClient(c)
 	return &cs
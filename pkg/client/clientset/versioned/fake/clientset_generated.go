@@ -7,6 +7,8 @@ package fake
 
 import (
 	clientset "github.com/verrazzano/verrazzano-helidon-app-operator/pkg/client/clientset/versioned"
+	verrazzanov1 "github.com/verrazzano/verrazzano-helidon-app-operator/pkg/client/clientset/versioned/typed/verrazzano/v1"
+	fakeverrazzanov1 "github.com/verrazzano/verrazzano-helidon-app-operator/pkg/client/clientset/versioned/typed/verrazzano/v1/fake"
 	verrazzanov1beta1 "github.com/verrazzano/verrazzano-helidon-app-operator/pkg/client/clientset/versioned/typed/verrazzano/v1beta1"
 	fakeverrazzanov1beta1 "github.com/verrazzano/verrazzano-helidon-app-operator/pkg/client/clientset/versioned/typed/verrazzano/v1beta1/fake"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -67,3 +69,8 @@ var _ clientset.Interface = &Clientset{}
 func (c *Clientset) VerrazzanoV1beta1() verrazzanov1beta1.VerrazzanoV1beta1Interface {
 	return &fakeverrazzanov1beta1.FakeVerrazzanoV1beta1{Fake: &c.Fake}
 }
+
+// VerrazzanoV1 retrieves the VerrazzanoV1Client
+func (c *Clientset) VerrazzanoV1() verrazzanov1.VerrazzanoV1Interface {
+	return &fakeverrazzanov1.FakeVerrazzanoV1{Fake: &c.Fake}
+}
@@ -0,0 +1,108 @@
+// Copyright (c) 2020, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package fake
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	vz "github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1"
+	vzv1beta1 "github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1beta1"
+	typedv1 "github.com/verrazzano/verrazzano-helidon-app-operator/pkg/client/clientset/versioned/typed/verrazzano/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Test updating the status of a HelidonApp through the typed client
+func TestUpdateStatus(t *testing.T) {
+	app := &vz.HelidonApp{ObjectMeta: metav1.ObjectMeta{Name: "myapp", Namespace: "myns"}}
+	app.Spec.Name = "myapp"
+	client := NewSimpleClientset(app).VerrazzanoV1().HelidonApps("myns")
+
+	found, err := client.Get(context.TODO(), "myapp", metav1.GetOptions{})
+	assert.NoError(t, err)
+	found.Status.State = "Deployed"
+	found.Status.ReadyReplicas = 1
+	_, err = client.UpdateStatus(context.TODO(), found, metav1.UpdateOptions{})
+	assert.NoError(t, err)
+
+	found, err = client.Get(context.TODO(), "myapp", metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, "Deployed", found.Status.State)
+	assert.Equal(t, int32(1), found.Status.ReadyReplicas)
+}
+
+// Test applying a HelidonApp and its status through the typed client
+func TestApply(t *testing.T) {
+	client := NewSimpleClientset().VerrazzanoV1().HelidonApps("myns")
+	opts := metav1.PatchOptions{FieldManager: "test"}
+
+	app := &vz.HelidonApp{ObjectMeta: metav1.ObjectMeta{Name: "myapp", Namespace: "myns", Labels: map[string]string{"app": "myapp"}}}
+	app.Spec.Name = "myapp"
+	app.Spec.Container.Image = "myimage:1"
+	_, err := client.Apply(context.TODO(), app, opts)
+	assert.NoError(t, err)
+
+	app.Spec.Container.Image = "myimage:2"
+	app.Status.State = "Ignored"
+	_, err = client.Apply(context.TODO(), app, opts)
+	assert.NoError(t, err)
+	found, err := client.Get(context.TODO(), "myapp", metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, "myimage:2", found.Spec.Container.Image)
+	assert.Equal(t, "myapp", found.Labels["app"])
+	assert.Equal(t, "", found.Status.State, "Expected Apply to leave the status")
+
+	status := &vz.HelidonApp{ObjectMeta: metav1.ObjectMeta{Name: "myapp", Namespace: "myns"}}
+	status.Status.State = "Deployed"
+	status.Status.ReadyReplicas = 1
+	_, err = client.ApplyStatus(context.TODO(), status, opts)
+	assert.NoError(t, err)
+	found, err = client.Get(context.TODO(), "myapp", metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, "Deployed", found.Status.State)
+	assert.Equal(t, int32(1), found.Status.ReadyReplicas)
+	assert.Equal(t, "myimage:2", found.Spec.Container.Image, "Expected ApplyStatus to leave the spec")
+}
+
+// Test applying a HelidonApp of the previous API version through the typed client
+func TestApplyV1beta1(t *testing.T) {
+	client := NewSimpleClientset().VerrazzanoV1beta1().HelidonApps("myns")
+	opts := metav1.PatchOptions{FieldManager: "test"}
+
+	app := &vzv1beta1.HelidonApp{ObjectMeta: metav1.ObjectMeta{Name: "myapp", Namespace: "myns"}}
+	app.Spec.Name = "myapp"
+	app.Spec.Image = "myimage:1"
+	_, err := client.Apply(context.TODO(), app, opts)
+	assert.NoError(t, err)
+	app.Status.State = "Deployed"
+	_, err = client.ApplyStatus(context.TODO(), app, opts)
+	assert.NoError(t, err)
+
+	found, err := client.Get(context.TODO(), "myapp", metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, "myimage:1", found.Spec.Image)
+	assert.Equal(t, "Deployed", found.Status.State)
+}
+
+// Test the body sent with server-side apply
+func TestApplyConfiguration(t *testing.T) {
+	app := &vz.HelidonApp{ObjectMeta: metav1.ObjectMeta{Name: "myapp", Namespace: "myns", ResourceVersion: "7"}}
+	app.Spec.Name = "myapp"
+	app.Status.State = "Deployed"
+
+	data, err := typedv1.ApplyConfiguration(app)
+	assert.NoError(t, err)
+	body := string(data)
+	assert.Contains(t, body, `"kind":"HelidonApp","apiVersion":"verrazzano.io/v1"`)
+	assert.Contains(t, body, `"name":"myapp"`)
+	assert.NotContains(t, body, "resourceVersion")
+	assert.NotContains(t, body, "Deployed")
+
+	data, err = typedv1.StatusApplyConfiguration(app)
+	assert.NoError(t, err)
+	body = string(data)
+	assert.Contains(t, body, `"state":"Deployed"`)
+	assert.NotContains(t, body, `"spec"`)
+}
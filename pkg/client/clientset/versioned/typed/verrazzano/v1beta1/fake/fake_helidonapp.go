@@ -9,6 +9,7 @@ import (
 	"context"
 
 	v1beta1 "github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1beta1"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	labels "k8s.io/apimachinery/pkg/labels"
 	schema "k8s.io/apimachinery/pkg/runtime/schema"
@@ -89,6 +90,18 @@ func (c *FakeHelidonApps) Update(ctx context.Context, helidonApp *v1beta1.Helido
 	return obj.(*v1beta1.HelidonApp), err
 }
 
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeHelidonApps) UpdateStatus(ctx context.Context, helidonApp *v1beta1.HelidonApp, opts v1.UpdateOptions) (*v1beta1.HelidonApp, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateSubresourceAction(helidonappsResource, "status", c.ns, helidonApp), &v1beta1.HelidonApp{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.HelidonApp), err
+}
+
 // Delete takes name of the helidonApp and deletes it. Returns an error if one occurs.
 func (c *FakeHelidonApps) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
 	_, err := c.Fake.
@@ -115,3 +128,25 @@ func (c *FakeHelidonApps) Patch(ctx context.Context, name string, pt types.Patch
 	}
 	return obj.(*v1beta1.HelidonApp), err
 }
+
+// GetScale takes name of the helidonApp, and returns the corresponding scale object, and an error if there is any.
+func (c *FakeHelidonApps) GetScale(ctx context.Context, helidonAppName string, options v1.GetOptions) (result *autoscalingv1.Scale, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewGetSubresourceAction(helidonappsResource, c.ns, "scale", helidonAppName), &autoscalingv1.Scale{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*autoscalingv1.Scale), err
+}
+
+// UpdateScale takes the representation of a scale and updates it. Returns the server's representation of the scale, and an error, if there is any.
+func (c *FakeHelidonApps) UpdateScale(ctx context.Context, helidonAppName string, scale *autoscalingv1.Scale, opts v1.UpdateOptions) (result *autoscalingv1.Scale, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateSubresourceAction(helidonappsResource, "scale", c.ns, scale), &autoscalingv1.Scale{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*autoscalingv1.Scale), err
+}
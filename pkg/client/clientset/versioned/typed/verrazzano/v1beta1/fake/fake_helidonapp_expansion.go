@@ -0,0 +1,42 @@
+// Copyright (c) 2020, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package fake
+
+import (
+	"context"
+	"encoding/json"
+
+	v1beta1 "github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1beta1"
+	typedv1beta1 "github.com/verrazzano/verrazzano-helidon-app-operator/pkg/client/clientset/versioned/typed/verrazzano/v1beta1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+)
+
+// Apply takes the representation of a helidonApp and applies it, creating it if needed. The object tracker
+// doesn't support server-side apply, it is emulated with a merge patch.
+func (c *FakeHelidonApps) Apply(ctx context.Context, helidonApp *v1beta1.HelidonApp, opts v1.PatchOptions) (*v1beta1.HelidonApp, error) {
+	data, err := typedv1beta1.ApplyConfiguration(helidonApp)
+	if err != nil {
+		return nil, err
+	}
+	result, err := c.Patch(ctx, helidonApp.Name, types.MergePatchType, data, opts)
+	if !errors.IsNotFound(err) {
+		return result, err
+	}
+	created := &v1beta1.HelidonApp{}
+	if err := json.Unmarshal(data, created); err != nil {
+		return nil, err
+	}
+	return c.Create(ctx, created, v1.CreateOptions{})
+}
+
+// ApplyStatus takes the representation of a helidonApp and applies its status, emulated with a merge patch
+func (c *FakeHelidonApps) ApplyStatus(ctx context.Context, helidonApp *v1beta1.HelidonApp, opts v1.PatchOptions) (*v1beta1.HelidonApp, error) {
+	data, err := typedv1beta1.StatusApplyConfiguration(helidonApp)
+	if err != nil {
+		return nil, err
+	}
+	return c.Patch(ctx, helidonApp.Name, types.MergePatchType, data, opts, "status")
+}
@@ -4,5 +4,3 @@
 // Code generated by client-gen. DO NOT EDIT.
 
 package v1beta1
-
-type HelidonAppExpansion interface{}
@@ -11,6 +11,7 @@ import (
 
 	v1beta1 "github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1beta1"
 	scheme "github.com/verrazzano/verrazzano-helidon-app-operator/pkg/client/clientset/versioned/scheme"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	types "k8s.io/apimachinery/pkg/types"
 	watch "k8s.io/apimachinery/pkg/watch"
@@ -27,12 +28,16 @@ type HelidonAppsGetter interface {
 type HelidonAppInterface interface {
 	Create(ctx context.Context, helidonApp *v1beta1.HelidonApp, opts v1.CreateOptions) (*v1beta1.HelidonApp, error)
 	Update(ctx context.Context, helidonApp *v1beta1.HelidonApp, opts v1.UpdateOptions) (*v1beta1.HelidonApp, error)
+	UpdateStatus(ctx context.Context, helidonApp *v1beta1.HelidonApp, opts v1.UpdateOptions) (*v1beta1.HelidonApp, error)
 	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
 	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
 	Get(ctx context.Context, name string, opts v1.GetOptions) (*v1beta1.HelidonApp, error)
 	List(ctx context.Context, opts v1.ListOptions) (*v1beta1.HelidonAppList, error)
 	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
 	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1beta1.HelidonApp, err error)
+	GetScale(ctx context.Context, helidonAppName string, options v1.GetOptions) (*autoscalingv1.Scale, error)
+	UpdateScale(ctx context.Context, helidonAppName string, scale *autoscalingv1.Scale, opts v1.UpdateOptions) (*autoscalingv1.Scale, error)
+
 	HelidonAppExpansion
 }
 
@@ -122,6 +127,22 @@ func (c *helidonApps) Update(ctx context.Context, helidonApp *v1beta1.HelidonApp
 	return
 }
 
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *helidonApps) UpdateStatus(ctx context.Context, helidonApp *v1beta1.HelidonApp, opts v1.UpdateOptions) (result *v1beta1.HelidonApp, err error) {
+	result = &v1beta1.HelidonApp{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("helidonapps").
+		Name(helidonApp.Name).
+		SubResource("status").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(helidonApp).
+		Do(ctx).
+		Into(result)
+	return
+}
+
 // Delete takes name of the helidonApp and deletes it. Returns an error if one occurs.
 func (c *helidonApps) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
 	return c.client.Delete().
@@ -163,3 +184,32 @@ func (c *helidonApps) Patch(ctx context.Context, name string, pt types.PatchType
 		Into(result)
 	return
 }
+
+// GetScale takes name of the helidonApp, and returns the corresponding autoscalingv1.Scale object, and an error if there is any.
+func (c *helidonApps) GetScale(ctx context.Context, helidonAppName string, options v1.GetOptions) (result *autoscalingv1.Scale, err error) {
+	result = &autoscalingv1.Scale{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("helidonapps").
+		Name(helidonAppName).
+		SubResource("scale").
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// UpdateScale takes the top resource name and the representation of a scale and updates it. Returns the server's representation of the scale, and an error, if there is any.
+func (c *helidonApps) UpdateScale(ctx context.Context, helidonAppName string, scale *autoscalingv1.Scale, opts v1.UpdateOptions) (result *autoscalingv1.Scale, err error) {
+	result = &autoscalingv1.Scale{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("helidonapps").
+		Name(helidonAppName).
+		SubResource("scale").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(scale).
+		Do(ctx).
+		Into(result)
+	return
+}
@@ -0,0 +1,75 @@
+// Copyright (c) 2020, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package v1beta1
+
+import (
+	"context"
+	"encoding/json"
+
+	v1beta1 "github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+)
+
+// HelidonAppExpansion adds server-side apply to the HelidonApp client. client-gen only generates Apply and
+// ApplyStatus with apply configurations from Kubernetes 0.21, so they are written on top of Patch.
+type HelidonAppExpansion interface {
+	Apply(ctx context.Context, helidonApp *v1beta1.HelidonApp, opts metav1.PatchOptions) (*v1beta1.HelidonApp, error)
+	ApplyStatus(ctx context.Context, helidonApp *v1beta1.HelidonApp, opts metav1.PatchOptions) (*v1beta1.HelidonApp, error)
+}
+
+// helidonAppApplyConfiguration holds the fields of a HelidonApp sent with server-side apply
+type helidonAppApplyConfiguration struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata"`
+	Spec              *v1beta1.HelidonAppSpec   `json:"spec,omitempty"`
+	Status            *v1beta1.HelidonAppStatus `json:"status,omitempty"`
+}
+
+// ApplyConfiguration returns the body of the server-side apply of a helidonApp by Apply: its name, namespace,
+// labels, annotations and spec. Unlike the apply configurations of client-gen, the field manager owns all the
+// fields of the spec, including those left empty.
+func ApplyConfiguration(helidonApp *v1beta1.HelidonApp) ([]byte, error) {
+	spec := helidonApp.Spec
+	return json.Marshal(&helidonAppApplyConfiguration{
+		TypeMeta: metav1.TypeMeta{APIVersion: v1beta1.SchemeGroupVersion.String(), Kind: "HelidonApp"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        helidonApp.Name,
+			Namespace:   helidonApp.Namespace,
+			Labels:      helidonApp.Labels,
+			Annotations: helidonApp.Annotations,
+		},
+		Spec: &spec,
+	})
+}
+
+// StatusApplyConfiguration returns the body of the server-side apply of the status of a helidonApp by ApplyStatus
+func StatusApplyConfiguration(helidonApp *v1beta1.HelidonApp) ([]byte, error) {
+	status := helidonApp.Status
+	return json.Marshal(&helidonAppApplyConfiguration{
+		TypeMeta:   metav1.TypeMeta{APIVersion: v1beta1.SchemeGroupVersion.String(), Kind: "HelidonApp"},
+		ObjectMeta: metav1.ObjectMeta{Name: helidonApp.Name, Namespace: helidonApp.Namespace},
+		Status:     &status,
+	})
+}
+
+// Apply takes the representation of a helidonApp and applies it with server-side apply, creating it if needed.
+// opts.FieldManager is required. Returns the server's representation of the helidonApp, and an error, if there is any.
+func (c *helidonApps) Apply(ctx context.Context, helidonApp *v1beta1.HelidonApp, opts metav1.PatchOptions) (*v1beta1.HelidonApp, error) {
+	data, err := ApplyConfiguration(helidonApp)
+	if err != nil {
+		return nil, err
+	}
+	return c.Patch(ctx, helidonApp.Name, types.ApplyPatchType, data, opts)
+}
+
+// ApplyStatus takes the representation of a helidonApp and applies its status with server-side apply.
+// opts.FieldManager is required. Returns the server's representation of the helidonApp, and an error, if there is any.
+func (c *helidonApps) ApplyStatus(ctx context.Context, helidonApp *v1beta1.HelidonApp, opts metav1.PatchOptions) (*v1beta1.HelidonApp, error) {
+	data, err := StatusApplyConfiguration(helidonApp)
+	if err != nil {
+		return nil, err
+	}
+	return c.Patch(ctx, helidonApp.Name, types.ApplyPatchType, data, opts, "status")
+}
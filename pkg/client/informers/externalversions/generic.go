@@ -8,6 +8,7 @@ package externalversions
 import (
 	"fmt"
 
+	v1 "github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1"
 	v1beta1 "github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1beta1"
 	schema "k8s.io/apimachinery/pkg/runtime/schema"
 	cache "k8s.io/client-go/tools/cache"
@@ -39,7 +40,15 @@ func (f *genericInformer) Lister() cache.GenericLister {
 // TODO extend this to unknown resources with a client pool
 func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource) (GenericInformer, error) {
 	switch resource {
-	// Group=verrazzano.io, Version=v1beta1
+	// Group=verrazzano.io, Version=v1
+	case v1.SchemeGroupVersion.WithResource("helidonapps"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Verrazzano().V1().HelidonApps().Informer()}, nil
+	case v1.SchemeGroupVersion.WithResource("helidonappimagepolicies"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Verrazzano().V1().HelidonAppImagePolicies().Informer()}, nil
+	case v1.SchemeGroupVersion.WithResource("helidonappnamespacegrants"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Verrazzano().V1().HelidonAppNamespaceGrants().Informer()}, nil
+
+		// Group=verrazzano.io, Version=v1beta1
 	case v1beta1.SchemeGroupVersion.WithResource("helidonapps"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Verrazzano().V1beta1().HelidonApps().Informer()}, nil
 
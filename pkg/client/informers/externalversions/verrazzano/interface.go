@@ -7,6 +7,7 @@ package verrazzano
 
 import (
 	internalinterfaces "github.com/verrazzano/verrazzano-helidon-app-operator/pkg/client/informers/externalversions/internalinterfaces"
+	v1 "github.com/verrazzano/verrazzano-helidon-app-operator/pkg/client/informers/externalversions/verrazzano/v1"
 	v1beta1 "github.com/verrazzano/verrazzano-helidon-app-operator/pkg/client/informers/externalversions/verrazzano/v1beta1"
 )
 
@@ -14,6 +15,8 @@ import (
 type Interface interface {
 	// V1beta1 provides access to shared informers for resources in V1beta1.
 	V1beta1() v1beta1.Interface
+	// V1 provides access to shared informers for resources in V1.
+	V1() v1.Interface
 }
 
 type group struct {
@@ -31,3 +34,8 @@ func New(f internalinterfaces.SharedInformerFactory, namespace string, tweakList
 func (g *group) V1beta1() v1beta1.Interface {
 	return v1beta1.New(g.factory, g.namespace, g.tweakListOptions)
 }
+
+// V1 returns a new v1.Interface.
+func (g *group) V1() v1.Interface {
+	return v1.New(g.factory, g.namespace, g.tweakListOptions)
+}
@@ -0,0 +1,41 @@
+// Copyright (c) 2020, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package helidonapp
+
+import (
+	"fmt"
+
+	"go.uber.org/zap"
+
+	verrazzanov1 "github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1"
+	"github.com/verrazzano/verrazzano-helidon-app-operator/pkg/importer"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// isAdoptEnabled checks if the HelidonApp asks to take over existing resources
+func isAdoptEnabled(cr *verrazzanov1.HelidonApp) bool {
+	return cr.Annotations[importer.AdoptAnnotation] == "true"
+}
+
+// isOwned checks if obj was created by, or was already adopted by, the HelidonApp
+func isOwned(cr *verrazzanov1.HelidonApp, obj metav1.Object) bool {
+	return hasOwnerLabels(cr, obj) || metav1.IsControlledBy(obj, cr)
+}
+
+// checkAdoption checks if an existing resource can be managed by the HelidonApp. Resources that aren't owned
+// by the HelidonApp are only taken over in place when adopt is enabled, they are labeled as owned when updated.
+// Returns false and updates the status when the resource can't be managed.
+func (r *ReconcileHelidonApp) checkAdoption(reqLogger *zap.SugaredLogger, cr *verrazzanov1.HelidonApp, obj metav1.Object, kind string) bool {
+	if isOwned(cr, obj) {
+		return true
+	}
+	if !isAdoptEnabled(cr) {
+		reqLogger.Infof("Existing %s is not owned by the HelidonApp, Name: %s Namespace: %s", kind, obj.GetName(), obj.GetNamespace())
+		r.updateStatusIfChanged(reqLogger, cr, "Failed", fmt.Sprintf("%s %s/%s already exists and is not owned by the HelidonApp, set the %s annotation to true to adopt it",
+			kind, obj.GetNamespace(), obj.GetName(), importer.AdoptAnnotation))
+		return false
+	}
+	reqLogger.Infof("Adopting existing %s, Name: %s Namespace: %s", kind, obj.GetName(), obj.GetNamespace())
+	return true
+}
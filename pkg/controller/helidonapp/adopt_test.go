@@ -0,0 +1,75 @@
+// Copyright (c) 2020, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package helidonapp
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	vz "github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1"
+	"github.com/verrazzano/verrazzano-helidon-app-operator/pkg/importer"
+	"github.com/verrazzano/verrazzano-helidon-app-operator/pkg/render"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// Test that existing resources not owned by the HelidonApp are only taken over when adopt is enabled
+func TestReconcileAdopt(t *testing.T) {
+	s := newTestScheme(t)
+	app := newTestApp("myapp", "crns", "crns")
+	app.Finalizers = []string{Finalizer}
+	deploy := render.Deployment(app)
+	deploy.Spec.Template.Labels = map[string]string{"app": "myapp", "tier": "web"}
+	svc := render.Service(app)
+	ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "crns"}}
+
+	c := fake.NewFakeClientWithScheme(s, app, deploy, svc, ns)
+	r := &ReconcileHelidonApp{client: c, scheme: s}
+	request := reconcile.Request{NamespacedName: types.NamespacedName{Namespace: "crns", Name: "myapp"}}
+	_, err := r.Reconcile(request)
+	assert.NoError(t, err)
+
+	found := &vz.HelidonApp{}
+	assert.NoError(t, c.Get(context.TODO(), request.NamespacedName, found))
+	assert.Equal(t, "Failed", found.Status.State, "Expected conflict without adopt")
+	deployFound := &appsv1.Deployment{}
+	assert.NoError(t, c.Get(context.TODO(), types.NamespacedName{Namespace: "crns", Name: "myapp"}, deployFound))
+	assert.False(t, hasOwnerLabels(app, deployFound), "Expected deployment to be left alone")
+
+	// The status isn't updated again while the conflict stays, the update would trigger another reconcile
+	_, err = r.Reconcile(request)
+	assert.NoError(t, err)
+	again := &vz.HelidonApp{}
+	assert.NoError(t, c.Get(context.TODO(), request.NamespacedName, again))
+	assert.Equal(t, found.ResourceVersion, again.ResourceVersion, "Expected the status not to be updated again")
+
+	found.Annotations = map[string]string{importer.AdoptAnnotation: "true"}
+	assert.NoError(t, c.Update(context.TODO(), found))
+	_, err = r.Reconcile(request)
+	assert.NoError(t, err)
+
+	assert.NoError(t, c.Get(context.TODO(), types.NamespacedName{Namespace: "crns", Name: "myapp"}, deployFound))
+	assert.True(t, hasOwnerLabels(app, deployFound), "Expected deployment to be adopted")
+	assert.Equal(t, "web", deployFound.Spec.Template.Labels["tier"], "Expected deployment to be adopted in place")
+	svcFound := &corev1.Service{}
+	assert.NoError(t, c.Get(context.TODO(), types.NamespacedName{Namespace: "crns", Name: "myapp"}, svcFound))
+	assert.True(t, hasOwnerLabels(app, svcFound), "Expected service to be adopted")
+}
+
+// Test that resources created by an earlier version of the operator, with an owner reference but without
+// owner labels, are owned
+func TestIsOwnedByControllerReference(t *testing.T) {
+	app := newTestApp("myapp", "crns", "crns")
+	app.UID = "uid"
+	deploy := render.Deployment(app)
+	assert.False(t, isOwned(app, deploy))
+	truth := true
+	deploy.OwnerReferences = []metav1.OwnerReference{{UID: "uid", Controller: &truth}}
+	assert.True(t, isOwned(app, deploy))
+}
@@ -0,0 +1,148 @@
+// Copyright (c) 2020, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package helidonapp
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	verrazzanov1 "github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1"
+	"github.com/verrazzano/verrazzano-helidon-app-operator/pkg/render"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// readyPollInterval is how often to check if a replacement Deployment is ready
+const readyPollInterval = 10 * time.Second
+
+// isTargetChanged checks if spec.name or spec.namespace changed since the resources were last applied
+func isTargetChanged(cr *verrazzanov1.HelidonApp) bool {
+	if cr.Status.AppliedName == "" && cr.Status.AppliedNamespace == "" {
+		return false
+	}
+	return cr.Status.AppliedName != cr.Spec.Name || cr.Status.AppliedNamespace != cr.Spec.Namespace
+}
+
+// isDeploymentReady checks if the deployment has rolled out and all of the desired replicas are available
+func isDeploymentReady(deployment *appsv1.Deployment) bool {
+	if deployment.Status.ObservedGeneration < deployment.Generation {
+		return false
+	}
+	var desired int32 = 1
+	if deployment.Spec.Replicas != nil {
+		desired = *deployment.Spec.Replicas
+	}
+	return deployment.Status.UpdatedReplicas >= desired && deployment.Status.AvailableReplicas >= desired
+}
+
+// reconcileAppliedResources records the name and namespace of the applied resources in the status. When
+// spec.name or spec.namespace changed, the previous Deployments and Services are deleted once the new
+// Deployment is ready. There may be several previous targets when the spec changed again before the
+// Deployment was ready, they are found by their owner labels.
+func (r *ReconcileHelidonApp) reconcileAppliedResources(reqLogger *zap.SugaredLogger, cr *verrazzanov1.HelidonApp, deployFound *appsv1.Deployment) (reconcile.Result, error) {
+	previous, err := r.previousTargets(cr)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	if len(previous) == 0 {
+		if cr.Status.AppliedName == cr.Spec.Name && cr.Status.AppliedNamespace == cr.Spec.Namespace {
+			return reconcile.Result{}, nil
+		}
+		// First reconcile that applied the resources, or the previous resources are gone, just record them
+		cr.Status.AppliedName = cr.Spec.Name
+		cr.Status.AppliedNamespace = cr.Spec.Namespace
+		return reconcile.Result{}, r.client.Status().Update(context.TODO(), cr)
+	}
+
+	if !isDeploymentReady(deployFound) {
+		reqLogger.Infof("Waiting for Deployment to be ready before deleting previous resources, Name: %s Namespace: %s", deployFound.Name, deployFound.Namespace)
+		return reconcile.Result{RequeueAfter: readyPollInterval}, nil
+	}
+
+	var moved []string
+	for _, target := range previous {
+		if err := r.deletePreviousResources(reqLogger, cr, target.Name, target.Namespace); err != nil {
+			r.updateStatus(reqLogger, cr, cr.Status.State, "Helidon application previous resources deletion failed: "+err.Error())
+			return reconcile.Result{}, err
+		}
+		moved = append(moved, target.String())
+	}
+
+	cr.Status.AppliedName = cr.Spec.Name
+	cr.Status.AppliedNamespace = cr.Spec.Namespace
+	err = r.updateStatus(reqLogger, cr, "Updated", fmt.Sprintf("Helidon application moved from %s to %s/%s",
+		strings.Join(moved, ", "), cr.Spec.Namespace, cr.Spec.Name))
+	return reconcile.Result{}, err
+}
+
+// previousTargets returns the names and namespaces, other than spec.name and spec.namespace, of the Deployments
+// and Services owned by the HelidonApp, along with the target recorded in the status if it changed
+func (r *ReconcileHelidonApp) previousTargets(cr *verrazzanov1.HelidonApp) ([]types.NamespacedName, error) {
+	current := types.NamespacedName{Name: cr.Spec.Name, Namespace: cr.Spec.Namespace}
+	seen := map[types.NamespacedName]bool{current: true}
+	var targets []types.NamespacedName
+	add := func(target types.NamespacedName) {
+		if !seen[target] {
+			seen[target] = true
+			targets = append(targets, target)
+		}
+	}
+
+	if isTargetChanged(cr) {
+		add(types.NamespacedName{Name: cr.Status.AppliedName, Namespace: cr.Status.AppliedNamespace})
+	}
+	deployments := &appsv1.DeploymentList{}
+	if err := r.client.List(context.TODO(), deployments, client.MatchingLabels(ownerLabels(cr))); err != nil {
+		return nil, err
+	}
+	for _, deployment := range deployments.Items {
+		add(types.NamespacedName{Name: deployment.Name, Namespace: deployment.Namespace})
+	}
+	services := &corev1.ServiceList{}
+	if err := r.client.List(context.TODO(), services, client.MatchingLabels(ownerLabels(cr))); err != nil {
+		return nil, err
+	}
+	for _, service := range services.Items {
+		add(types.NamespacedName{Name: service.Name, Namespace: service.Namespace})
+	}
+	return targets, nil
+}
+
+// deletePreviousResources deletes the Deployment, Service and logging ConfigMap of the given name and namespace,
+// provided they are owned by the HelidonApp
+func (r *ReconcileHelidonApp) deletePreviousResources(reqLogger *zap.SugaredLogger, cr *verrazzanov1.HelidonApp, name string, namespace string) error {
+	previous := []struct {
+		name string
+		obj  runtime.Object
+	}{{name, &appsv1.Deployment{}}, {name, &corev1.Service{}}, {render.LoggingConfigMapName(name), &corev1.ConfigMap{}}}
+	for _, p := range previous {
+		name, obj := p.name, p.obj
+		err := r.client.Get(context.TODO(), types.NamespacedName{Name: name, Namespace: namespace}, obj)
+		if errors.IsNotFound(err) {
+			continue
+		} else if err != nil {
+			return err
+		}
+		meta := obj.(metav1.Object)
+		if !hasOwnerLabels(cr, meta) {
+			reqLogger.Infof("Not deleting %T not owned by the HelidonApp, Name: %s Namespace: %s", obj, name, namespace)
+			continue
+		}
+		reqLogger.Infof("Deleting previous %T, Name: %s Namespace: %s", obj, name, namespace)
+		if err := r.client.Delete(context.TODO(), obj); err != nil && !errors.IsNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,141 @@
+// Copyright (c) 2020, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package helidonapp
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	vz "github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1"
+	"github.com/verrazzano/verrazzano-helidon-app-operator/pkg/render"
+	"go.uber.org/zap"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestIsDeploymentReady(t *testing.T) {
+	deploy := &appsv1.Deployment{}
+	assert.False(t, isDeploymentReady(deploy), "Expected deployment with no available replicas to not be ready")
+	deploy.Status.UpdatedReplicas = 1
+	deploy.Status.AvailableReplicas = 1
+	assert.True(t, isDeploymentReady(deploy), "Expected deployment to be ready")
+	deploy.Generation = 2
+	deploy.Status.ObservedGeneration = 1
+	assert.False(t, isDeploymentReady(deploy), "Expected deployment with unobserved generation to not be ready")
+	var replicas int32 = 0
+	deploy.Spec.Replicas = &replicas
+	deploy.Status.ObservedGeneration = 2
+	deploy.Status.UpdatedReplicas = 0
+	deploy.Status.AvailableReplicas = 0
+	assert.True(t, isDeploymentReady(deploy), "Expected deployment scaled to zero to be ready")
+}
+
+// Test that the first reconcile records the applied resources in the status
+func TestReconcileAppliedResourcesRecordsTarget(t *testing.T) {
+	s := newTestScheme(t)
+	app := newTestApp("myapp", "crns", "crns")
+	c := fake.NewFakeClientWithScheme(s, app)
+	r := &ReconcileHelidonApp{client: c, scheme: s}
+
+	result, err := r.reconcileAppliedResources(zap.S(), app, render.Deployment(app))
+	assert.NoError(t, err)
+	assert.False(t, result.Requeue || result.RequeueAfter > 0, "Expected no requeue")
+
+	found := &vz.HelidonApp{}
+	assert.NoError(t, c.Get(context.TODO(), types.NamespacedName{Namespace: "crns", Name: "myapp"}, found))
+	assert.Equal(t, "myapp", found.Status.AppliedName)
+	assert.Equal(t, "crns", found.Status.AppliedNamespace)
+}
+
+// Test that the previous resources are only deleted once the replacement deployment is ready
+func TestReconcileAppliedResourcesMovesApp(t *testing.T) {
+	s := newTestScheme(t)
+	previous := newTestApp("myapp", "crns", "oldns")
+	oldDeploy := render.Deployment(previous)
+	assert.NoError(t, setOwnership(previous, oldDeploy, s))
+	oldService := render.Service(previous)
+	assert.NoError(t, setOwnership(previous, oldService, s))
+
+	app := newTestApp("myapp", "crns", "crns")
+	app.Spec.Name = "renamed"
+	app.Status.AppliedName = "myapp"
+	app.Status.AppliedNamespace = "oldns"
+	newDeploy := render.Deployment(app)
+	assert.NoError(t, setOwnership(app, newDeploy, s))
+
+	c := fake.NewFakeClientWithScheme(s, app, oldDeploy, oldService, newDeploy)
+	r := &ReconcileHelidonApp{client: c, scheme: s}
+
+	result, err := r.reconcileAppliedResources(zap.S(), app, newDeploy)
+	assert.NoError(t, err)
+	assert.Equal(t, readyPollInterval, result.RequeueAfter, "Expected requeue while the new deployment is not ready")
+	assert.NoError(t, c.Get(context.TODO(), types.NamespacedName{Namespace: "oldns", Name: "myapp"}, &appsv1.Deployment{}),
+		"Expected previous deployment to be kept")
+
+	newDeploy.Status.UpdatedReplicas = 1
+	newDeploy.Status.AvailableReplicas = 1
+	result, err = r.reconcileAppliedResources(zap.S(), app, newDeploy)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), int64(result.RequeueAfter), "Expected no requeue")
+
+	err = c.Get(context.TODO(), types.NamespacedName{Namespace: "oldns", Name: "myapp"}, &appsv1.Deployment{})
+	assert.True(t, errors.IsNotFound(err), "Expected previous deployment to be deleted")
+	err = c.Get(context.TODO(), types.NamespacedName{Namespace: "oldns", Name: "myapp"}, &corev1.Service{})
+	assert.True(t, errors.IsNotFound(err), "Expected previous service to be deleted")
+
+	found := &vz.HelidonApp{}
+	assert.NoError(t, c.Get(context.TODO(), types.NamespacedName{Namespace: "crns", Name: "myapp"}, found))
+	assert.Equal(t, "renamed", found.Status.AppliedName)
+	assert.Equal(t, "crns", found.Status.AppliedNamespace)
+	assert.Equal(t, "Updated", found.Status.State)
+}
+
+// Test that the resources of every previous target are deleted when the spec changed again before the
+// replacement deployment was ready
+func TestReconcileAppliedResourcesDoubleRename(t *testing.T) {
+	s := newTestScheme(t)
+	first := newTestApp("myapp", "crns", "oldns")
+	firstDeploy := render.Deployment(first)
+	assert.NoError(t, setOwnership(first, firstDeploy, s))
+	second := newTestApp("myapp", "crns", "midns")
+	second.Spec.Name = "middle"
+	secondDeploy := render.Deployment(second)
+	assert.NoError(t, setOwnership(second, secondDeploy, s))
+	secondService := render.Service(second)
+	assert.NoError(t, setOwnership(second, secondService, s))
+
+	// Only the first target is recorded, the second was never ready
+	app := newTestApp("myapp", "crns", "crns")
+	app.Spec.Name = "renamed"
+	app.Status.AppliedName = "myapp"
+	app.Status.AppliedNamespace = "oldns"
+	newDeploy := render.Deployment(app)
+	assert.NoError(t, setOwnership(app, newDeploy, s))
+	newDeploy.Status.UpdatedReplicas = 1
+	newDeploy.Status.AvailableReplicas = 1
+
+	c := fake.NewFakeClientWithScheme(s, app, firstDeploy, secondDeploy, secondService, newDeploy)
+	r := &ReconcileHelidonApp{client: c, scheme: s}
+
+	_, err := r.reconcileAppliedResources(zap.S(), app, newDeploy)
+	assert.NoError(t, err)
+	err = c.Get(context.TODO(), types.NamespacedName{Namespace: "oldns", Name: "myapp"}, &appsv1.Deployment{})
+	assert.True(t, errors.IsNotFound(err), "Expected first deployment to be deleted")
+	err = c.Get(context.TODO(), types.NamespacedName{Namespace: "midns", Name: "middle"}, &appsv1.Deployment{})
+	assert.True(t, errors.IsNotFound(err), "Expected second deployment to be deleted")
+	err = c.Get(context.TODO(), types.NamespacedName{Namespace: "midns", Name: "middle"}, &corev1.Service{})
+	assert.True(t, errors.IsNotFound(err), "Expected second service to be deleted")
+	assert.NoError(t, c.Get(context.TODO(), types.NamespacedName{Namespace: "crns", Name: "renamed"}, &appsv1.Deployment{}),
+		"Expected current deployment to be kept")
+
+	found := &vz.HelidonApp{}
+	assert.NoError(t, c.Get(context.TODO(), types.NamespacedName{Namespace: "crns", Name: "myapp"}, found))
+	assert.Equal(t, "renamed", found.Status.AppliedName)
+	assert.Equal(t, "crns", found.Status.AppliedNamespace)
+	assert.Equal(t, "Helidon application moved from oldns/myapp, midns/middle to crns/renamed", found.Status.LastActionMessage)
+}
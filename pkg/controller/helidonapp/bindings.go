@@ -0,0 +1,65 @@
+// Copyright (c) 2020, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package helidonapp
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"go.uber.org/zap"
+
+	verrazzanov1 "github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// resolveBindings records the Secrets of the bindings to provisioned services in the status, so they can be
+// projected. Returns a description of the first provisioned service that isn't ready, empty when all are.
+func (r *ReconcileHelidonApp) resolveBindings(reqLogger *zap.SugaredLogger, cr *verrazzanov1.HelidonApp) (string, error) {
+	// Provisioned services live in spec.namespace, which may not be watched
+	reader := r.apiReader
+	if reader == nil {
+		reader = r.client
+	}
+	var statuses []verrazzanov1.BindingStatus
+	pending := ""
+	for _, binding := range cr.Spec.Bindings {
+		ref := binding.Service
+		if ref == nil {
+			continue
+		}
+		service := &unstructured.Unstructured{}
+		service.SetAPIVersion(ref.APIVersion)
+		service.SetKind(ref.Kind)
+		err := reader.Get(context.TODO(), types.NamespacedName{Namespace: cr.Spec.Namespace, Name: ref.Name}, service)
+		if errors.IsNotFound(err) {
+			if pending == "" {
+				pending = fmt.Sprintf("provisioned service %s %s/%s of binding %s not found", ref.Kind, cr.Spec.Namespace, ref.Name, binding.Name)
+			}
+			continue
+		} else if err != nil {
+			return "", err
+		}
+		secret, _, _ := unstructured.NestedString(service.Object, "status", "binding", "name")
+		if secret == "" {
+			if pending == "" {
+				pending = fmt.Sprintf("provisioned service %s %s/%s of binding %s has no status.binding.name", ref.Kind, cr.Spec.Namespace, ref.Name, binding.Name)
+			}
+			continue
+		}
+		statuses = append(statuses, verrazzanov1.BindingStatus{Name: binding.Name, Secret: secret})
+	}
+
+	if reflect.DeepEqual(statuses, cr.Status.Bindings) {
+		return pending, nil
+	}
+	cr.Status.Bindings = statuses
+	err := r.client.Status().Update(context.TODO(), cr)
+	if err != nil {
+		reqLogger.Errorf("Failed to update HelidonApp status, Name: %s Namespace: %s, Error: %s", cr.Name, cr.Namespace, err.Error())
+	}
+	return pending, err
+}
@@ -0,0 +1,62 @@
+// Copyright (c) 2020, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package helidonapp
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	vz "github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1"
+	appsv1 "k8s.io/api/apps/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// Test that the Deployment waits for the provisioned service of a binding to name its Secret
+func TestReconcileBindings(t *testing.T) {
+	s := newTestScheme(t)
+	app := newTestApp("myapp", "myns", "myns")
+	app.Spec.Bindings = []vz.ServiceBinding{{Name: "db", Service: &vz.BindingServiceReference{APIVersion: "example.com/v1", Kind: "Database", Name: "orders"}}}
+	service := &unstructured.Unstructured{}
+	service.SetAPIVersion("example.com/v1")
+	service.SetKind("Database")
+	service.SetNamespace("myns")
+	service.SetName("orders")
+	c := fake.NewFakeClientWithScheme(s, app, service)
+	r := &ReconcileHelidonApp{client: c, scheme: s}
+	request := reconcile.Request{NamespacedName: types.NamespacedName{Namespace: "myns", Name: "myapp"}}
+
+	// The first reconcile creates the namespace, the next ones wait for the binding
+	for i := 0; i < 2; i++ {
+		_, err := r.Reconcile(request)
+		assert.NoError(t, err)
+	}
+	result, err := r.Reconcile(request)
+	assert.NoError(t, err)
+	assert.Equal(t, readyPollInterval, result.RequeueAfter)
+	found := &vz.HelidonApp{}
+	assert.NoError(t, c.Get(context.TODO(), request.NamespacedName, found))
+	assert.Equal(t, "Pending", found.Status.State)
+	assert.Contains(t, found.Status.LastActionMessage, "provisioned service Database myns/orders of binding db has no status.binding.name")
+	assert.True(t, errors.IsNotFound(c.Get(context.TODO(), request.NamespacedName, &appsv1.Deployment{})))
+
+	assert.NoError(t, unstructured.SetNestedField(service.Object, "orders-binding", "status", "binding", "name"))
+	assert.NoError(t, c.Update(context.TODO(), service))
+	_, err = r.Reconcile(request)
+	assert.NoError(t, err)
+	found = &vz.HelidonApp{}
+	assert.NoError(t, c.Get(context.TODO(), request.NamespacedName, found))
+	assert.Equal(t, []vz.BindingStatus{{Name: "db", Secret: "orders-binding"}}, found.Status.Bindings)
+	deployment := &appsv1.Deployment{}
+	assert.NoError(t, c.Get(context.TODO(), request.NamespacedName, deployment))
+	volumes := deployment.Spec.Template.Spec.Volumes
+	if assert.Len(t, volumes, 1) {
+		assert.Equal(t, "orders-binding", volumes[0].Secret.SecretName)
+	}
+	assert.Equal(t, []configReference{{"Secret", "orders-binding"}}, configReferences(found))
+}
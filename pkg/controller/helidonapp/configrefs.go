@@ -0,0 +1,239 @@
+// Copyright (c) 2020, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package helidonapp
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strings"
+
+	"go.uber.org/zap"
+
+	verrazzanov1 "github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1"
+	"github.com/verrazzano/verrazzano-helidon-app-operator/pkg/bindings"
+	"github.com/verrazzano/verrazzano-helidon-app-operator/pkg/database"
+	"github.com/verrazzano/verrazzano-helidon-app-operator/pkg/helidon"
+	"github.com/verrazzano/verrazzano-helidon-app-operator/pkg/render"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+const (
+	// ConfigHashAnnotation on the pod template holds the hash of the ConfigMaps and Secrets referenced by the
+	// HelidonApp, so that changing them rolls the pods
+	ConfigHashAnnotation = "helidonapp.verrazzano.io/config-hash"
+
+	// RolloutExcludeAnnotation lists the references that don't roll the pods when they change, as a comma
+	// separated list of ConfigMap/<name> and Secret/<name>
+	RolloutExcludeAnnotation = "helidonapp.verrazzano.io/rollout-exclude"
+
+	// Indexes of HelidonApps by the <namespace>/<name> of the ConfigMaps and Secrets they reference
+	configMapIndex = "helidonapp.configMapRefs"
+	secretIndex    = "helidonapp.secretRefs"
+)
+
+// configReference is a ConfigMap or Secret referenced by a HelidonApp, in spec.namespace
+type configReference struct {
+	kind string
+	name string
+}
+
+func (c configReference) String() string {
+	return c.kind + "/" + c.name
+}
+
+// configReferences returns the sorted ConfigMaps and Secrets referenced from the env, envFrom and volumes of
+// the HelidonApp, from spec.helidon, spec.logging, spec.bindings and spec.database, without the excluded ones
+func configReferences(cr *verrazzanov1.HelidonApp) []configReference {
+	refs := make(map[configReference]bool)
+	addEnv := func(env []corev1.EnvVar) {
+		for _, e := range env {
+			if e.ValueFrom == nil {
+				continue
+			}
+			if e.ValueFrom.ConfigMapKeyRef != nil {
+				refs[configReference{"ConfigMap", e.ValueFrom.ConfigMapKeyRef.Name}] = true
+			}
+			if e.ValueFrom.SecretKeyRef != nil {
+				refs[configReference{"Secret", e.ValueFrom.SecretKeyRef.Name}] = true
+			}
+		}
+	}
+	addContainer := func(c corev1.Container) {
+		addEnv(c.Env)
+		for _, e := range c.EnvFrom {
+			if e.ConfigMapRef != nil {
+				refs[configReference{"ConfigMap", e.ConfigMapRef.Name}] = true
+			}
+			if e.SecretRef != nil {
+				refs[configReference{"Secret", e.SecretRef.Name}] = true
+			}
+		}
+	}
+
+	addEnv(cr.Spec.Container.Env)
+	for _, c := range cr.Spec.Containers {
+		addContainer(c)
+	}
+	for _, c := range cr.Spec.InitContainers {
+		addContainer(c)
+	}
+	for _, v := range cr.Spec.Volumes {
+		if v.ConfigMap != nil {
+			refs[configReference{"ConfigMap", v.ConfigMap.Name}] = true
+		}
+		if v.Secret != nil {
+			refs[configReference{"Secret", v.Secret.SecretName}] = true
+		}
+		if v.Projected != nil {
+			for _, source := range v.Projected.Sources {
+				if source.ConfigMap != nil {
+					refs[configReference{"ConfigMap", source.ConfigMap.Name}] = true
+				}
+				if source.Secret != nil {
+					refs[configReference{"Secret", source.Secret.Name}] = true
+				}
+			}
+		}
+	}
+
+	if spec := cr.Spec.Helidon; spec != nil && spec.ConfigMap != "" {
+		refs[configReference{"ConfigMap", spec.ConfigMap}] = true
+	}
+	for _, binding := range cr.Spec.Bindings {
+		refs[configReference{"Secret", bindings.SecretName(cr, binding)}] = true
+	}
+	if wallet := database.Wallet(cr); wallet != nil {
+		refs[configReference{"Secret", wallet.Secret}] = true
+	}
+	// The logging ConfigMap only rolls the pods when the application doesn't reload it
+	if cr.Spec.Logging != nil && !helidon.LoggingInPlace(cr) {
+		refs[configReference{"ConfigMap", render.LoggingConfigMapName(cr.Spec.Name)}] = true
+	}
+
+	excluded := make(map[string]bool)
+	for _, value := range strings.Split(cr.Annotations[RolloutExcludeAnnotation], ",") {
+		excluded[strings.ToLower(strings.TrimSpace(value))] = true
+	}
+	var result []configReference
+	for ref := range refs {
+		if ref.name != "" && !excluded[strings.ToLower(ref.String())] {
+			result = append(result, ref)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].String() < result[j].String() })
+	return result
+}
+
+// configHash returns the hash of the content of the ConfigMaps and Secrets referenced by the HelidonApp, or
+// an empty string if there are none. Missing objects are part of the hash, so creating them rolls the pods.
+func (r *ReconcileHelidonApp) configHash(cr *verrazzanov1.HelidonApp) (string, error) {
+	refs := configReferences(cr)
+	if len(refs) == 0 {
+		return "", nil
+	}
+	hash := sha256.New()
+	for _, ref := range refs {
+		key := types.NamespacedName{Namespace: cr.Spec.Namespace, Name: ref.name}
+		data := make(map[string][]byte)
+		var err error
+		if ref.kind == "ConfigMap" {
+			configMap := &corev1.ConfigMap{}
+			err = r.client.Get(context.TODO(), key, configMap)
+			for k, v := range configMap.Data {
+				data[k] = []byte(v)
+			}
+			for k, v := range configMap.BinaryData {
+				data[k] = v
+			}
+		} else {
+			secret := &corev1.Secret{}
+			err = r.client.Get(context.TODO(), key, secret)
+			data = secret.Data
+		}
+		if errors.IsNotFound(err) {
+			fmt.Fprintf(hash, "%s missing\n", ref)
+			continue
+		} else if err != nil {
+			return "", err
+		}
+
+		fmt.Fprintf(hash, "%s\n", ref)
+		keys := make([]string, 0, len(data))
+		for k := range data {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			fmt.Fprintf(hash, "%s=%d:", k, len(data[k]))
+			hash.Write(data[k])
+			hash.Write([]byte("\n"))
+		}
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// updateConfigHash sets the config hash annotation in place, or removes it when the hash is empty.
+// Returns true if the annotation changed.
+func updateConfigHash(meta *metav1.ObjectMeta, hash string) bool {
+	current, exists := meta.Annotations[ConfigHashAnnotation]
+	if hash == "" {
+		delete(meta.Annotations, ConfigHashAnnotation)
+		return exists
+	}
+	if exists && current == hash {
+		return false
+	}
+	if meta.Annotations == nil {
+		meta.Annotations = make(map[string]string)
+	}
+	meta.Annotations[ConfigHashAnnotation] = hash
+	return true
+}
+
+// configIndexer returns an indexer of HelidonApps by the <namespace>/<name> of the referenced objects of a kind
+func configIndexer(kind string) client.IndexerFunc {
+	return func(obj runtime.Object) []string {
+		cr, ok := obj.(*verrazzanov1.HelidonApp)
+		if !ok {
+			return nil
+		}
+		var values []string
+		for _, ref := range configReferences(cr) {
+			if ref.kind == kind {
+				values = append(values, cr.Spec.Namespace+"/"+ref.name)
+			}
+		}
+		return values
+	}
+}
+
+// configRequestMapper returns a mapper that enqueues the HelidonApps referencing a ConfigMap or Secret,
+// looked up with the index
+func configRequestMapper(c client.Client, index string) handler.ToRequestsFunc {
+	return func(a handler.MapObject) []reconcile.Request {
+		apps := &verrazzanov1.HelidonAppList{}
+		err := c.List(context.TODO(), apps, client.MatchingFields{index: a.Meta.GetNamespace() + "/" + a.Meta.GetName()})
+		if err != nil {
+			zap.S().Errorf("Failed to list HelidonApps referencing %s/%s, Error: %s", a.Meta.GetNamespace(), a.Meta.GetName(), err.Error())
+			return nil
+		}
+		var requests []reconcile.Request
+		for _, app := range apps.Items {
+			requests = append(requests, reconcile.Request{
+				NamespacedName: types.NamespacedName{Namespace: app.Namespace, Name: app.Name},
+			})
+		}
+		return requests
+	}
+}
@@ -0,0 +1,98 @@
+// Copyright (c) 2020, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package helidonapp
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	vz "github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// Test collecting the ConfigMaps and Secrets referenced from env, envFrom and volumes
+func TestConfigReferences(t *testing.T) {
+	app := newTestConfigApp()
+	assert.Equal(t, []configReference{{"ConfigMap", "appconfig"}, {"ConfigMap", "sidecarconfig"}, {"Secret", "dbsecret"}, {"Secret", "tls"}},
+		configReferences(app))
+
+	app.Annotations = map[string]string{RolloutExcludeAnnotation: "secret/tls, ConfigMap/sidecarconfig"}
+	assert.Equal(t, []configReference{{"ConfigMap", "appconfig"}, {"Secret", "dbsecret"}}, configReferences(app))
+
+	assert.Equal(t, []string{"targetns/appconfig"}, configIndexer("ConfigMap")(app))
+	assert.Equal(t, []string{"targetns/dbsecret"}, configIndexer("Secret")(app))
+}
+
+// Test that changing a referenced Secret changes the hash on the pod template
+func TestReconcileConfigHash(t *testing.T) {
+	s := newTestScheme(t)
+	app := newTestConfigApp()
+	app.Namespace = "targetns"
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "dbsecret", Namespace: "targetns"},
+		Data:       map[string][]byte{"password": []byte("old")},
+	}
+	c := fake.NewFakeClientWithScheme(s, app, secret)
+	r := &ReconcileHelidonApp{client: c, scheme: s}
+	request := reconcile.Request{NamespacedName: types.NamespacedName{Namespace: "targetns", Name: "myapp"}}
+
+	// Reconciles create the namespace, the Deployment and the Service one at a time
+	for i := 0; i < 3; i++ {
+		_, err := r.Reconcile(request)
+		assert.NoError(t, err)
+	}
+	deploymentName := types.NamespacedName{Namespace: "targetns", Name: "myapp"}
+	deployment := &appsv1.Deployment{}
+	assert.NoError(t, c.Get(context.TODO(), deploymentName, deployment))
+	oldHash := deployment.Spec.Template.Annotations[ConfigHashAnnotation]
+	assert.NotEmpty(t, oldHash)
+	assert.NotContains(t, deployment.Annotations, ConfigHashAnnotation)
+
+	secret.Data["password"] = []byte("new")
+	assert.NoError(t, c.Update(context.TODO(), secret))
+	_, err := r.Reconcile(request)
+	assert.NoError(t, err)
+	deployment = &appsv1.Deployment{}
+	assert.NoError(t, c.Get(context.TODO(), deploymentName, deployment))
+	newHash := deployment.Spec.Template.Annotations[ConfigHashAnnotation]
+	assert.NotEmpty(t, newHash)
+	assert.NotEqual(t, oldHash, newHash)
+
+	// Excluded references don't roll the pods
+	found := &vz.HelidonApp{}
+	assert.NoError(t, c.Get(context.TODO(), request.NamespacedName, found))
+	found.Annotations = map[string]string{RolloutExcludeAnnotation: "Secret/dbsecret,Secret/tls,ConfigMap/appconfig,ConfigMap/sidecarconfig"}
+	assert.NoError(t, c.Update(context.TODO(), found))
+	_, err = r.Reconcile(request)
+	assert.NoError(t, err)
+	deployment = &appsv1.Deployment{}
+	assert.NoError(t, c.Get(context.TODO(), deploymentName, deployment))
+	assert.NotContains(t, deployment.Spec.Template.Annotations, ConfigHashAnnotation)
+}
+
+func newTestConfigApp() *vz.HelidonApp {
+	app := newTestApp("myapp", "crns", "targetns")
+	app.Spec.Container.Image = "myapp:1.0"
+	app.Spec.Container.Env = []corev1.EnvVar{
+		{Name: "PLAIN", Value: "value"},
+		{Name: "DB_PASSWORD", ValueFrom: &corev1.EnvVarSource{SecretKeyRef: &corev1.SecretKeySelector{
+			LocalObjectReference: corev1.LocalObjectReference{Name: "dbsecret"}, Key: "password"}}},
+	}
+	app.Spec.Containers = []corev1.Container{{
+		Name:    "sidecar",
+		EnvFrom: []corev1.EnvFromSource{{ConfigMapRef: &corev1.ConfigMapEnvSource{LocalObjectReference: corev1.LocalObjectReference{Name: "sidecarconfig"}}}},
+	}}
+	app.Spec.Volumes = []corev1.Volume{
+		{Name: "config", VolumeSource: corev1.VolumeSource{ConfigMap: &corev1.ConfigMapVolumeSource{
+			LocalObjectReference: corev1.LocalObjectReference{Name: "appconfig"}}}},
+		{Name: "tls", VolumeSource: corev1.VolumeSource{Secret: &corev1.SecretVolumeSource{SecretName: "tls"}}},
+	}
+	return app
+}
@@ -0,0 +1,58 @@
+// Copyright (c) 2020, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package helidonapp
+
+import (
+	"fmt"
+
+	verrazzanov1 "github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1"
+	"github.com/verrazzano/verrazzano-helidon-app-operator/pkg/helidon"
+	"github.com/verrazzano/verrazzano-helidon-app-operator/pkg/render"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// updateDebugPort adds, changes or removes the JDWP port of the main container in place, the other ports are
+// left alone. Returns true if the ports changed.
+func updateDebugPort(cr *verrazzanov1.HelidonApp, container *corev1.Container) bool {
+	var ports []corev1.ContainerPort
+	var current *corev1.ContainerPort
+	for i := range container.Ports {
+		if container.Ports[i].Name == render.DebugPortName {
+			current = &container.Ports[i]
+			continue
+		}
+		ports = append(ports, container.Ports[i])
+	}
+	if !helidon.DebugEnabled(cr) {
+		if current == nil {
+			return false
+		}
+		container.Ports = ports
+		return true
+	}
+	if current != nil && current.ContainerPort == helidon.DebugPort(cr) {
+		return false
+	}
+	container.Ports = append(ports, corev1.ContainerPort{Name: render.DebugPortName, ContainerPort: helidon.DebugPort(cr), Protocol: corev1.ProtocolTCP})
+	return true
+}
+
+// setDebugStatus sets the Debugging condition while spec.debug is enabled, and removes it otherwise
+func setDebugStatus(status *verrazzanov1.HelidonAppStatus, cr *verrazzanov1.HelidonApp) {
+	debug := cr.Spec.Debug
+	if debug == nil || !debug.Enabled {
+		removeCondition(status, verrazzanov1.ConditionDebugging)
+		return
+	}
+	if !helidon.DebugEnabled(cr) {
+		setCondition(status, verrazzanov1.ConditionDebugging, corev1.ConditionFalse, "RuntimeNotSupported",
+			fmt.Sprintf("Remote debugging is not supported by the %s runtime", helidon.Runtime(cr)))
+		return
+	}
+	message := fmt.Sprintf("The JDWP agent listens on port %d of a single replica", helidon.DebugPort(cr))
+	if debug.Suspend {
+		message += ", the application waits for a debugger to attach"
+	}
+	setCondition(status, verrazzanov1.ConditionDebugging, corev1.ConditionTrue, "DebugEnabled", message)
+}
@@ -0,0 +1,86 @@
+// Copyright (c) 2020, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package helidonapp
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	vz "github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// Test that enabling spec.debug adds the JDWP port and the Debugging condition, and that disabling it restores
+// the Deployment
+func TestReconcileDebug(t *testing.T) {
+	s := newTestScheme(t)
+	app := newTestApp("myapp", "myns", "myns")
+	app.Spec.Container.Image = "myapp:1.0"
+	replicas := int32(2)
+	app.Spec.Scaling.Replicas = &replicas
+	c := fake.NewFakeClientWithScheme(s, app)
+	r := &ReconcileHelidonApp{client: c, scheme: s}
+	request := reconcile.Request{NamespacedName: types.NamespacedName{Namespace: "myns", Name: "myapp"}}
+
+	// Reconciles create the namespace, the Deployment and the Service one at a time
+	for i := 0; i < 3; i++ {
+		_, err := r.Reconcile(request)
+		assert.NoError(t, err)
+	}
+
+	found := &vz.HelidonApp{}
+	assert.NoError(t, c.Get(context.TODO(), request.NamespacedName, found))
+	found.Spec.Debug = &vz.DebugSpec{Enabled: true, Port: 8000}
+	assert.NoError(t, c.Update(context.TODO(), found))
+	_, err := r.Reconcile(request)
+	assert.NoError(t, err)
+
+	deployment := &appsv1.Deployment{}
+	assert.NoError(t, c.Get(context.TODO(), request.NamespacedName, deployment))
+	main := deployment.Spec.Template.Spec.Containers[0]
+	assert.Equal(t, int32(1), *deployment.Spec.Replicas)
+	assert.Equal(t, []corev1.ContainerPort{{ContainerPort: 8080}, {Name: "jdwp", ContainerPort: 8000, Protocol: corev1.ProtocolTCP}}, main.Ports)
+	assert.Equal(t, "JAVA_TOOL_OPTIONS", main.Env[0].Name)
+	found = &vz.HelidonApp{}
+	assert.NoError(t, c.Get(context.TODO(), request.NamespacedName, found))
+	condition := findCondition(&found.Status, vz.ConditionDebugging)
+	if assert.NotNil(t, condition) {
+		assert.Equal(t, corev1.ConditionTrue, condition.Status)
+		assert.Equal(t, "DebugEnabled", condition.Reason)
+	}
+
+	found.Spec.Debug.Enabled = false
+	assert.NoError(t, c.Update(context.TODO(), found))
+	_, err = r.Reconcile(request)
+	assert.NoError(t, err)
+
+	deployment = &appsv1.Deployment{}
+	assert.NoError(t, c.Get(context.TODO(), request.NamespacedName, deployment))
+	main = deployment.Spec.Template.Spec.Containers[0]
+	assert.Equal(t, int32(2), *deployment.Spec.Replicas)
+	assert.Equal(t, []corev1.ContainerPort{{ContainerPort: 8080}}, main.Ports)
+	assert.Empty(t, main.Env)
+	found = &vz.HelidonApp{}
+	assert.NoError(t, c.Get(context.TODO(), request.NamespacedName, found))
+	assert.Nil(t, findCondition(&found.Status, vz.ConditionDebugging))
+}
+
+// Test the Debugging condition of a native HelidonApp
+func TestSetDebugStatus(t *testing.T) {
+	app := &vz.HelidonApp{}
+	app.Spec.Runtime = vz.HelidonRuntimeNative
+	app.Spec.Debug = &vz.DebugSpec{Enabled: true}
+	status := &vz.HelidonAppStatus{}
+	setDebugStatus(status, app)
+	condition := findCondition(status, vz.ConditionDebugging)
+	if assert.NotNil(t, condition) {
+		assert.Equal(t, corev1.ConditionFalse, condition.Status)
+		assert.Equal(t, "RuntimeNotSupported", condition.Reason)
+	}
+}
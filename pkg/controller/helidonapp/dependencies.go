@@ -0,0 +1,138 @@
+// Copyright (c) 2020, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package helidonapp
+
+import (
+	"context"
+	"reflect"
+	"strings"
+
+	"go.uber.org/zap"
+
+	verrazzanov1 "github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1"
+	"github.com/verrazzano/verrazzano-helidon-app-operator/pkg/dependencies"
+	"github.com/verrazzano/verrazzano-helidon-app-operator/pkg/render"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// dependencyIndex indexes HelidonApps by the <namespace>/<name> of the HelidonApps they depend on
+const dependencyIndex = "helidonapp.dependsOn"
+
+// dependencyIndexer indexes HelidonApps by the <namespace>/<name> of their spec.dependsOn
+func dependencyIndexer(obj runtime.Object) []string {
+	cr, ok := obj.(*verrazzanov1.HelidonApp)
+	if !ok {
+		return nil
+	}
+	var values []string
+	for _, dependency := range cr.Spec.DependsOn {
+		values = append(values, dependencies.Key(cr, dependency).String())
+	}
+	return values
+}
+
+// dependentRequestMapper returns a mapper that enqueues the HelidonApps depending on a HelidonApp, looked up
+// with the index
+func dependentRequestMapper(c client.Client) handler.ToRequestsFunc {
+	return func(a handler.MapObject) []reconcile.Request {
+		apps := &verrazzanov1.HelidonAppList{}
+		key := types.NamespacedName{Namespace: a.Meta.GetNamespace(), Name: a.Meta.GetName()}
+		err := c.List(context.TODO(), apps, client.MatchingFields{dependencyIndex: key.String()})
+		if err != nil {
+			zap.S().Errorf("Failed to list HelidonApps depending on %s, Error: %s", key, err.Error())
+			return nil
+		}
+		var requests []reconcile.Request
+		for _, app := range apps.Items {
+			requests = append(requests, reconcile.Request{
+				NamespacedName: types.NamespacedName{Namespace: app.Namespace, Name: app.Name},
+			})
+		}
+		return requests
+	}
+}
+
+// checkDependencies records the Services and readiness of spec.dependsOn in the status, with the
+// WaitingForDependencies condition. Returns why the Deployment waits, empty when all the dependencies are Ready.
+func (r *ReconcileHelidonApp) checkDependencies(reqLogger *zap.SugaredLogger, cr *verrazzanov1.HelidonApp) (string, error) {
+	status := cr.Status.DeepCopy()
+	waiting, err := r.resolveDependencies(cr, status)
+	if err != nil {
+		return "", err
+	}
+	if reflect.DeepEqual(*status, cr.Status) {
+		return waiting, nil
+	}
+	cr.Status = *status
+	err = r.client.Status().Update(context.TODO(), cr)
+	if err != nil {
+		reqLogger.Errorf("Failed to update HelidonApp status, Name: %s Namespace: %s, Error: %s", cr.Name, cr.Namespace, err.Error())
+	}
+	return waiting, err
+}
+
+// resolveDependencies sets the dependencies and the WaitingForDependencies condition of the status
+func (r *ReconcileHelidonApp) resolveDependencies(cr *verrazzanov1.HelidonApp, status *verrazzanov1.HelidonAppStatus) (string, error) {
+	if len(cr.Spec.DependsOn) == 0 {
+		status.Dependencies = nil
+		removeCondition(status, verrazzanov1.ConditionWaitingForDependencies)
+		return "", nil
+	}
+
+	// A cycle never gets Ready, the whole graph is needed to find it
+	apps := &verrazzanov1.HelidonAppList{}
+	if err := r.client.List(context.TODO(), apps); err != nil {
+		return "", err
+	}
+	cycle := dependencies.NewGraph(apps.Items).Cycle(types.NamespacedName{Namespace: cr.Namespace, Name: cr.Name})
+
+	status.Dependencies = nil
+	var notReady []string
+	for _, dependency := range cr.Spec.DependsOn {
+		key := dependencies.Key(cr, dependency)
+		app := &verrazzanov1.HelidonApp{}
+		err := r.client.Get(context.TODO(), key, app)
+		if errors.IsNotFound(err) {
+			notReady = append(notReady, key.String()+" (not found)")
+			status.Dependencies = append(status.Dependencies, verrazzanov1.DependencyStatus{Name: key.Name, Namespace: key.Namespace})
+			continue
+		} else if err != nil {
+			return "", err
+		}
+		ready := false
+		if condition := findCondition(&app.Status, verrazzanov1.ConditionReady); condition != nil {
+			ready = condition.Status == corev1.ConditionTrue
+		}
+		if !ready {
+			notReady = append(notReady, key.String())
+		}
+		status.Dependencies = append(status.Dependencies, verrazzanov1.DependencyStatus{
+			Name:      key.Name,
+			Namespace: key.Namespace,
+			Address:   render.ServiceAddress(app),
+			Ready:     ready,
+		})
+	}
+
+	switch {
+	case cycle != nil:
+		message := "Dependency cycle " + dependencies.FormatCycle(cycle)
+		setCondition(status, verrazzanov1.ConditionWaitingForDependencies, corev1.ConditionTrue, "DependencyCycle", message)
+		return message, nil
+	case len(notReady) > 0:
+		message := "Waiting for " + strings.Join(notReady, ", ") + " to be Ready"
+		setCondition(status, verrazzanov1.ConditionWaitingForDependencies, corev1.ConditionTrue, "DependenciesNotReady", message)
+		return message, nil
+	default:
+		setCondition(status, verrazzanov1.ConditionWaitingForDependencies, corev1.ConditionFalse, "DependenciesReady",
+			"All the dependencies of the Helidon application are Ready")
+		return "", nil
+	}
+}
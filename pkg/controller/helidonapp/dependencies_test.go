@@ -0,0 +1,115 @@
+// Copyright (c) 2020, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package helidonapp
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	vz "github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// setTestReady sets the Ready condition of a HelidonApp
+func setTestReady(t *testing.T, c client.Client, key types.NamespacedName, ready corev1.ConditionStatus) {
+	app := &vz.HelidonApp{}
+	assert.NoError(t, c.Get(context.TODO(), key, app))
+	setCondition(&app.Status, vz.ConditionReady, ready, "Test", "")
+	assert.NoError(t, c.Status().Update(context.TODO(), app))
+}
+
+// Test the Deployment is only created and upgraded while the dependencies are Ready
+func TestReconcileDependencies(t *testing.T) {
+	s := newTestScheme(t)
+	app := newTestApp("orders", "myns", "myns")
+	app.Spec.DependsOn = []vz.Dependency{{Name: "catalog", WaitFor: true}}
+	catalog := newTestApp("catalog", "myns", "catalog-ns")
+	c := fake.NewFakeClientWithScheme(s, app, catalog)
+	r := &ReconcileHelidonApp{client: c, scheme: s}
+	request := reconcile.Request{NamespacedName: types.NamespacedName{Namespace: "myns", Name: "orders"}}
+	catalogKey := types.NamespacedName{Namespace: "myns", Name: "catalog"}
+
+	// The first reconcile creates the namespace, the next ones wait for the dependency
+	for i := 0; i < 2; i++ {
+		_, err := r.Reconcile(request)
+		assert.NoError(t, err)
+	}
+	found := &vz.HelidonApp{}
+	assert.NoError(t, c.Get(context.TODO(), request.NamespacedName, found))
+	condition := findCondition(&found.Status, vz.ConditionWaitingForDependencies)
+	if assert.NotNil(t, condition) {
+		assert.Equal(t, corev1.ConditionTrue, condition.Status)
+		assert.Equal(t, "DependenciesNotReady", condition.Reason)
+		assert.Equal(t, "Waiting for myns/catalog to be Ready", condition.Message)
+	}
+	assert.Equal(t, []vz.DependencyStatus{{Name: "catalog", Namespace: "myns", Address: "catalog.catalog-ns.svc:8080"}}, found.Status.Dependencies)
+	assert.True(t, errors.IsNotFound(c.Get(context.TODO(), request.NamespacedName, &appsv1.Deployment{})))
+
+	setTestReady(t, c, catalogKey, corev1.ConditionTrue)
+	_, err := r.Reconcile(request)
+	assert.NoError(t, err)
+	deployment := &appsv1.Deployment{}
+	assert.NoError(t, c.Get(context.TODO(), request.NamespacedName, deployment))
+	initContainers := deployment.Spec.Template.Spec.InitContainers
+	if assert.Len(t, initContainers, 1) {
+		assert.Equal(t, "wait-for-catalog", initContainers[0].Name)
+	}
+	found = &vz.HelidonApp{}
+	assert.NoError(t, c.Get(context.TODO(), request.NamespacedName, found))
+	assert.Equal(t, corev1.ConditionFalse, findCondition(&found.Status, vz.ConditionWaitingForDependencies).Status)
+
+	// A new image isn't rolled out while the dependency is not Ready
+	_, err = r.Reconcile(request)
+	assert.NoError(t, err)
+	setTestReady(t, c, catalogKey, corev1.ConditionFalse)
+	found = &vz.HelidonApp{}
+	assert.NoError(t, c.Get(context.TODO(), request.NamespacedName, found))
+	found.Spec.Container.Image = "myimage:2"
+	assert.NoError(t, c.Update(context.TODO(), found))
+	_, err = r.Reconcile(request)
+	assert.NoError(t, err)
+	deployment = &appsv1.Deployment{}
+	assert.NoError(t, c.Get(context.TODO(), request.NamespacedName, deployment))
+	assert.Equal(t, "myimage", deployment.Spec.Template.Spec.Containers[0].Image)
+
+	setTestReady(t, c, catalogKey, corev1.ConditionTrue)
+	_, err = r.Reconcile(request)
+	assert.NoError(t, err)
+	deployment = &appsv1.Deployment{}
+	assert.NoError(t, c.Get(context.TODO(), request.NamespacedName, deployment))
+	assert.Equal(t, "myimage:2", deployment.Spec.Template.Spec.Containers[0].Image)
+}
+
+// Test HelidonApps depending on each other are never deployed
+func TestReconcileDependencyCycle(t *testing.T) {
+	s := newTestScheme(t)
+	orders := newTestApp("orders", "myns", "myns")
+	orders.Spec.DependsOn = []vz.Dependency{{Name: "payments"}}
+	payments := newTestApp("payments", "myns", "myns")
+	payments.Spec.DependsOn = []vz.Dependency{{Name: "orders"}}
+	c := fake.NewFakeClientWithScheme(s, orders, payments)
+	r := &ReconcileHelidonApp{client: c, scheme: s}
+	request := reconcile.Request{NamespacedName: types.NamespacedName{Namespace: "myns", Name: "orders"}}
+	setTestReady(t, c, types.NamespacedName{Namespace: "myns", Name: "payments"}, corev1.ConditionTrue)
+
+	for i := 0; i < 3; i++ {
+		_, err := r.Reconcile(request)
+		assert.NoError(t, err)
+	}
+	found := &vz.HelidonApp{}
+	assert.NoError(t, c.Get(context.TODO(), request.NamespacedName, found))
+	condition := findCondition(&found.Status, vz.ConditionWaitingForDependencies)
+	if assert.NotNil(t, condition) {
+		assert.Equal(t, "DependencyCycle", condition.Reason)
+		assert.Equal(t, "Dependency cycle myns/orders -> myns/payments -> myns/orders", condition.Message)
+	}
+	assert.True(t, errors.IsNotFound(c.Get(context.TODO(), request.NamespacedName, &appsv1.Deployment{})))
+}
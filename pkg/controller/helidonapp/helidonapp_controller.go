@@ -11,17 +11,24 @@ import (
 
 	"go.uber.org/zap"
 
-	verrazzanov1beta1 "github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1beta1"
+	verrazzanov1 "github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1"
+	"github.com/verrazzano/verrazzano-helidon-app-operator/pkg/helidon"
+	"github.com/verrazzano/verrazzano-helidon-app-operator/pkg/namespaces"
+	"github.com/verrazzano/verrazzano-helidon-app-operator/pkg/render"
+	"github.com/verrazzano/verrazzano-helidon-app-operator/pkg/tenancy"
 	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
-	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
@@ -33,6 +40,22 @@ import (
 * business logic.  Delete these comments after modifying this file.*
  */
 
+// Options holds the operator wide settings for the HelidonApp controller
+type Options struct {
+	// NamespaceSelector restricts reconciliation to HelidonApps in namespaces matching the selector.
+	// Namespaces are picked up and dropped as their labels change. The cache still holds all namespaces.
+	NamespaceSelector labels.Selector
+	// PlanMode records the changes to the resources of the HelidonApps in their status instead of applying
+	// them. It can be overridden for a HelidonApp with the plan annotation.
+	PlanMode bool
+	// Tenancy restricts the target namespace and the ServiceAccount of HelidonApps
+	Tenancy tenancy.Policy
+}
+
+// ControllerOptions are the settings used when the controller is added to the Manager.
+// They must be set before Add is called.
+var ControllerOptions = Options{}
+
 // Add creates a new HelidonApp Controller and adds it to the Manager. The Manager will set fields on the Controller
 // and Start it when the Manager is Started.
 func Add(mgr manager.Manager) error {
@@ -41,7 +64,15 @@ func Add(mgr manager.Manager) error {
 
 // newReconciler returns a new reconcile.Reconciler
 func newReconciler(mgr manager.Manager) reconcile.Reconciler {
-	return &ReconcileHelidonApp{client: mgr.GetClient(), scheme: mgr.GetScheme()}
+	return &ReconcileHelidonApp{
+		client:          mgr.GetClient(),
+		scheme:          mgr.GetScheme(),
+		namespaceFilter: &namespaces.Filter{Selector: ControllerOptions.NamespaceSelector, Reader: mgr.GetClient()},
+		recorder:        mgr.GetEventRecorderFor("helidon-app-operator"),
+		planMode:        ControllerOptions.PlanMode,
+		tenancy:         ControllerOptions.Tenancy,
+		apiReader:       mgr.GetAPIReader(),
+	}
 }
 
 // add adds a new Controller to mgr with r as the reconcile.Reconciler
@@ -53,10 +84,94 @@ func add(mgr manager.Manager, r reconcile.Reconciler) error {
 	}
 
 	// Watch for changes to primary resource HelidonApp
-	err = c.Watch(&source.Kind{Type: &verrazzanov1beta1.HelidonApp{}}, &handler.EnqueueRequestForObject{})
+	err = c.Watch(&source.Kind{Type: &verrazzanov1.HelidonApp{}}, &handler.EnqueueRequestForObject{})
+	if err != nil {
+		return err
+	}
+
+	// Watch for changes to secondary resources and map them back to the owning HelidonApp using the owner labels,
+	// since resources created in another namespace can't carry an owner reference
+	err = c.Watch(&source.Kind{Type: &appsv1.Deployment{}}, &handler.EnqueueRequestsFromMapFunc{ToRequests: ownerRequestMapper})
 	if err != nil {
 		return err
 	}
+	err = c.Watch(&source.Kind{Type: &corev1.Service{}}, &handler.EnqueueRequestsFromMapFunc{ToRequests: ownerRequestMapper})
+	if err != nil {
+		return err
+	}
+	err = c.Watch(&source.Kind{Type: &corev1.ConfigMap{}}, &handler.EnqueueRequestsFromMapFunc{ToRequests: ownerRequestMapper})
+	if err != nil {
+		return err
+	}
+	err = c.Watch(&source.Kind{Type: &batchv1.Job{}}, &handler.EnqueueRequestsFromMapFunc{ToRequests: ownerRequestMapper})
+	if err != nil {
+		return err
+	}
+
+	// Watch for changes to the ConfigMaps and Secrets referenced by HelidonApps, to roll their pods
+	err = mgr.GetFieldIndexer().IndexField(context.TODO(), &verrazzanov1.HelidonApp{}, configMapIndex, configIndexer("ConfigMap"))
+	if err != nil {
+		return err
+	}
+	err = mgr.GetFieldIndexer().IndexField(context.TODO(), &verrazzanov1.HelidonApp{}, secretIndex, configIndexer("Secret"))
+	if err != nil {
+		return err
+	}
+	err = c.Watch(&source.Kind{Type: &corev1.ConfigMap{}},
+		&handler.EnqueueRequestsFromMapFunc{ToRequests: configRequestMapper(mgr.GetClient(), configMapIndex)})
+	if err != nil {
+		return err
+	}
+	err = c.Watch(&source.Kind{Type: &corev1.Secret{}},
+		&handler.EnqueueRequestsFromMapFunc{ToRequests: configRequestMapper(mgr.GetClient(), secretIndex)})
+	if err != nil {
+		return err
+	}
+
+	// Watch for changes to HelidonApps, to reconcile the HelidonApps depending on them
+	err = mgr.GetFieldIndexer().IndexField(context.TODO(), &verrazzanov1.HelidonApp{}, dependencyIndex, dependencyIndexer)
+	if err != nil {
+		return err
+	}
+	err = c.Watch(&source.Kind{Type: &verrazzanov1.HelidonApp{}},
+		&handler.EnqueueRequestsFromMapFunc{ToRequests: dependentRequestMapper(mgr.GetClient())})
+	if err != nil {
+		return err
+	}
+
+	// Watch for changes to the pods of HelidonApps, to report their problems
+	err = mgr.GetFieldIndexer().IndexField(context.TODO(), &verrazzanov1.HelidonApp{}, appIndex, appIndexer)
+	if err != nil {
+		return err
+	}
+	err = c.Watch(&source.Kind{Type: &corev1.Pod{}}, &handler.EnqueueRequestsFromMapFunc{ToRequests: podRequestMapper(mgr.GetClient())})
+	if err != nil {
+		return err
+	}
+
+	// Watch for changes to grants, which may allow or stop HelidonApps from deploying to another namespace
+	err = c.Watch(&source.Kind{Type: &verrazzanov1.HelidonAppNamespaceGrant{}},
+		&handler.EnqueueRequestsFromMapFunc{ToRequests: grantRequestMapper(mgr.GetClient())})
+	if err != nil {
+		return err
+	}
+
+	// Watch for changes to image policies, which may allow or stop HelidonApps from deploying
+	err = c.Watch(&source.Kind{Type: &verrazzanov1.HelidonAppImagePolicy{}},
+		&handler.EnqueueRequestsFromMapFunc{ToRequests: imagePolicyRequestMapper(mgr.GetCache())})
+	if err != nil {
+		return err
+	}
+
+	// Watch for label changes on namespaces so HelidonApps are picked up as soon as their namespace is selected
+	if ControllerOptions.NamespaceSelector != nil {
+		err = c.Watch(&source.Kind{Type: &corev1.Namespace{}},
+			&handler.EnqueueRequestsFromMapFunc{ToRequests: namespaceRequestMapper(mgr.GetClient())},
+			namespaceLabelsChanged)
+		if err != nil {
+			return err
+		}
+	}
 
 	return nil
 }
@@ -70,6 +185,18 @@ type ReconcileHelidonApp struct {
 	// that reads objects from the cache and writes to the apiserver
 	client client.Client
 	scheme *runtime.Scheme
+	// namespaceFilter decides which namespaces are reconciled, nil means all namespaces
+	namespaceFilter *namespaces.Filter
+	recorder        record.EventRecorder
+	// planMode is the operator wide plan mode, see Options
+	planMode bool
+	tenancy  tenancy.Policy
+	// clock returns the current time for evaluating schedules, time.Now when nil
+	clock func() time.Time
+	// apiReader reads grants, image policies and namespaces directly from the API server, they may live outside
+	// of the watched namespaces.
+	// The client is used when nil.
+	apiReader client.Reader
 }
 
 // Reconcile reads that state of the cluster for a HelidonApp object and makes changes based on the state read
@@ -82,7 +209,7 @@ func (r *ReconcileHelidonApp) Reconcile(request reconcile.Request) (reconcile.Re
 	reqLogger := zap.S().With("Request.Namespace", request.Namespace, "Request.Name", request.Name)
 	reqLogger.Infow("Reconciling HelidonApp")
 	// Fetch the HelidonApp instance
-	instance := &verrazzanov1beta1.HelidonApp{}
+	instance := &verrazzanov1.HelidonApp{}
 	err := r.client.Get(context.TODO(), request.NamespacedName, instance)
 	if err != nil {
 		// If the resource is not found, that means all of
@@ -98,6 +225,52 @@ func (r *ReconcileHelidonApp) Reconcile(request reconcile.Request) (reconcile.Re
 		return reconcile.Result{}, err
 	}
 
+	// The HelidonApp is being deleted, clean up the resources that garbage collection can't reach
+	if instance.GetDeletionTimestamp() != nil {
+		err = r.finalize(reqLogger, instance)
+		if err != nil {
+			reqLogger.Errorf("Failed to finalize HelidonApp, Error: %s", err.Error())
+		}
+		return reconcile.Result{}, err
+	}
+
+	// Skip HelidonApps in namespaces that are not selected
+	watched, err := r.namespaceFilter.Matches(context.TODO(), instance.Namespace)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	if !watched {
+		reqLogger.Debugw("Namespace not selected, skipping HelidonApp")
+		return reconcile.Result{}, nil
+	}
+
+	// Enforce the tenancy and image policies before any resource is created or updated
+	allowed, err := r.checkPolicies(reqLogger, instance)
+	if !allowed || err != nil {
+		return reconcile.Result{}, err
+	}
+
+	// Reject invalid Helidon settings, which the validating webhook catches only when it is installed
+	err = helidon.Validate(instance)
+	if err != nil {
+		reqLogger.Errorf("Invalid Helidon settings, Name: %s Namespace: %s, Error: %s", instance.Name, instance.Namespace, err.Error())
+		return reconcile.Result{}, r.updateStatusIfChanged(reqLogger, instance, "Failed", "Helidon application has invalid Helidon settings: "+err.Error())
+	}
+
+	// In plan mode, only record the changes that would be applied
+	if isPlanMode(instance, r.planMode) {
+		return r.reconcilePlan(reqLogger, instance)
+	}
+	err = r.clearPlan(reqLogger, instance)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	err = r.ensureFinalizer(reqLogger, instance)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
 	// Check if the namespace for the Helidon application exists, if not found create it
 	// Define a new Namespace object
 	namespaceFound := &corev1.Namespace{}
@@ -105,7 +278,7 @@ func (r *ReconcileHelidonApp) Reconcile(request reconcile.Request) (reconcile.Re
 	err = r.client.Get(context.TODO(), types.NamespacedName{Name: instance.Spec.Namespace}, namespaceFound)
 	if err != nil && errors.IsNotFound(err) {
 		reqLogger.Infof("Creating a new namespace, Namespace: %s", instance.Spec.Namespace)
-		err = r.client.Create(context.TODO(), newNamespace(instance))
+		err = r.client.Create(context.TODO(), render.Namespace(instance))
 		if err != nil {
 			return reconcile.Result{}, err
 		}
@@ -124,7 +297,11 @@ func (r *ReconcileHelidonApp) Reconcile(request reconcile.Request) (reconcile.Re
 		err = r.client.Get(context.TODO(), types.NamespacedName{Name: instance.Spec.ServiceAccountName, Namespace: instance.Spec.Namespace}, saFound)
 		if err != nil && errors.IsNotFound(err) {
 			reqLogger.Infof("Creating a new serviceaccount, Name: %s Namespace: %s", instance.Spec.ServiceAccountName, instance.Spec.Namespace)
-			err = r.client.Create(context.TODO(), newServiceAccount(instance))
+			serviceAccount := render.ServiceAccount(instance)
+			if err := setOwnership(instance, serviceAccount, r.scheme); err != nil {
+				return reconcile.Result{}, err
+			}
+			err = r.client.Create(context.TODO(), serviceAccount)
 			if err != nil {
 				return reconcile.Result{}, err
 			}
@@ -136,20 +313,87 @@ func (r *ReconcileHelidonApp) Reconcile(request reconcile.Request) (reconcile.Re
 		}
 	}
 
-	// Define a new Deployment object
-	deployment := newDeployment(instance)
+	// Wait for the provisioned services of spec.bindings to name their Secret
+	pending, err := r.resolveBindings(reqLogger, instance)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	if pending != "" {
+		reqLogger.Infof("Waiting for binding, %s", pending)
+		return reconcile.Result{RequeueAfter: readyPollInterval},
+			r.updateStatusIfChanged(reqLogger, instance, "Pending", "Helidon application is waiting for a binding: "+pending)
+	}
+	if instance.Status.State == "Pending" {
+		err = r.updateStatus(reqLogger, instance, "Deployed", "Helidon application bindings are ready")
+		if err != nil {
+			return reconcile.Result{}, err
+		}
+	}
+
+	// Check the wallet of spec.database before it is rolled out, changes to its Secret trigger a reconcile
+	problem, err := r.checkWallet(instance)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	if problem != "" {
+		reqLogger.Infof("Invalid database wallet, %s", problem)
+		return reconcile.Result{}, r.updateStatusIfChanged(reqLogger, instance, "Failed", "Helidon application database wallet is invalid: "+problem)
+	}
+
+	// Hold off creating or upgrading the Deployment until the HelidonApps of spec.dependsOn are Ready, changes
+	// to them trigger a reconcile
+	waiting, err := r.checkDependencies(reqLogger, instance)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	// Create, update or delete the ConfigMap of spec.logging
+	stop, result, err := r.reconcileLoggingConfigMap(reqLogger, instance)
+	if stop || err != nil {
+		return result, err
+	}
+
+	// Evaluate spec.suspend and spec.schedule
+	replicas, activeSchedule, nextSchedule, err := r.evaluateSchedule(instance)
+	if err != nil {
+		reqLogger.Errorf("Invalid schedule, Name: %s Namespace: %s, Error: %s", instance.Name, instance.Namespace, err.Error())
+		return reconcile.Result{}, r.updateStatusIfChanged(reqLogger, instance, "Failed", "Helidon application schedule is invalid: "+err.Error())
+	}
+	err = r.updateScheduleStatus(reqLogger, instance, activeSchedule, nextSchedule)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	// Define a new Deployment object, with the replicas of the schedule and the hash of the referenced
+	// ConfigMaps and Secrets
+	deployment := render.Deployment(instance)
+	deployment.Spec.Replicas = &replicas
+	hash, err := r.configHash(instance)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	updateConfigHash(&deployment.Spec.Template.ObjectMeta, hash)
 
-	// Set HelidonApp instance as the owner and controller of the deployment
-	// This reference will result in the deployment resource being deleted when the CR is deleted
-	if err := controllerutil.SetControllerReference(instance, deployment, r.scheme); err != nil {
+	// Set HelidonApp instance as the owner of the deployment
+	// This will result in the deployment resource being deleted when the CR is deleted
+	if err := setOwnership(instance, deployment, r.scheme); err != nil {
 		return reconcile.Result{}, err
 	}
+	render.SetRuntimeLabel(instance, deployment)
 
 	// Check if this Deployment already exists
 	deployFound := &appsv1.Deployment{}
 	reqLogger.Infow("Checking if deployment exist")
 	err = r.client.Get(context.TODO(), types.NamespacedName{Name: deployment.Name, Namespace: deployment.Namespace}, deployFound)
 	if err != nil && errors.IsNotFound(err) {
+		if waiting != "" {
+			reqLogger.Infof("Not creating the Deployment, %s", waiting)
+			return reconcile.Result{}, nil
+		}
+		phase, err := r.runPreDeployHook(reqLogger, instance, podRevision(&deployment.Spec.Template))
+		if phase != verrazzanov1.HookSucceeded || err != nil {
+			return requeueWhileHookRuns(reconcile.Result{}, phase == verrazzanov1.HookRunning), err
+		}
 		reqLogger.Infof("Creating a new Deployment. Name: %s Namespace: %s", deployment.Name, deployment.Namespace)
 		err = r.client.Create(context.TODO(), deployment)
 		if err != nil {
@@ -165,13 +409,16 @@ func (r *ReconcileHelidonApp) Reconcile(request reconcile.Request) (reconcile.Re
 	} else if err != nil {
 		return reconcile.Result{}, err
 	}
+	if !r.checkAdoption(reqLogger, instance, deployFound, "Deployment") {
+		return reconcile.Result{}, nil
+	}
 
 	// Define a new Service object
-	service := newService(instance)
+	service := render.Service(instance)
 
-	// Set HelidonApp instance as the owner and controller of the service
-	// This reference will result in the service resource being deleted when the CR is deleted
-	if err := controllerutil.SetControllerReference(instance, service, r.scheme); err != nil {
+	// Set HelidonApp instance as the owner of the service
+	// This will result in the service resource being deleted when the CR is deleted
+	if err := setOwnership(instance, service, r.scheme); err != nil {
 		return reconcile.Result{}, err
 	}
 
@@ -195,21 +442,23 @@ func (r *ReconcileHelidonApp) Reconcile(request reconcile.Request) (reconcile.Re
 	} else if err != nil {
 		return reconcile.Result{}, err
 	}
+	if !r.checkAdoption(reqLogger, instance, serviceFound, "Service") {
+		return reconcile.Result{}, nil
+	}
 
 	// Let's update the deployment if needed
-	err = r.doUpdateIfNeeded(reqLogger, instance, deployFound)
+	preDeployRunning, err := r.doUpdateIfNeeded(reqLogger, instance, deployFound, waiting)
 	if err != nil {
 		return reconcile.Result{}, err
 	}
 
 	// Let's update the service if needed
-	if instance.Spec.Port != 0 && instance.Spec.Port != serviceFound.Spec.Ports[0].Port {
+	serviceUpdateNeeded, err := r.updateService(instance, serviceFound)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	if serviceUpdateNeeded {
 		reqLogger.Infof("Updating Service, Name: %s Namespace: %s", serviceFound.Name, serviceFound.Namespace)
-		serviceFound.Spec.Ports[0].Port = instance.Spec.Port
-		serviceFound.Spec.Ports[0].TargetPort = intstr.IntOrString{
-			Type:   intstr.Int,
-			IntVal: instance.Spec.Port,
-		}
 		err = r.client.Update(context.TODO(), serviceFound)
 		if err != nil {
 			reqLogger.Errorf("Failed to update Service, Name: %s Namespace: %s, Error: %s", service.Name, service.Namespace, err.Error())
@@ -220,192 +469,178 @@ func (r *ReconcileHelidonApp) Reconcile(request reconcile.Request) (reconcile.Re
 		r.updateStatus(reqLogger, instance, "Updated", "Helidon application service updated")
 	}
 
-	// Helidon application updated - don't requue
-	return reconcile.Result{}, nil
-}
-
-// newDeployment returns a deployment for creating/updating a Helidon application deployment
-func newDeployment(cr *verrazzanov1beta1.HelidonApp) *appsv1.Deployment {
-	labels := make(map[string]string)
-	labels["app"] = cr.Spec.Name
-
-	port, targetPort := getPorts(cr)
-
-	annotations := make(map[string]string)
-	annotations["prometheus.io/scrape"] = "true"
-	annotations["prometheus.io/port"] = fmt.Sprint(targetPort)
-	annotations["prometheus.io/path"] = "/metrics"
-
-	containers := []corev1.Container{
-		{
-			Name:            cr.Spec.Name,
-			Image:           cr.Spec.Image,
-			ImagePullPolicy: cr.Spec.ImagePullPolicy,
-			Ports: []corev1.ContainerPort{
-				{
-					ContainerPort: port,
-				},
-			},
-			Env: cr.Spec.Env,
-		},
-	}
-
-	// Include any additional containers specified in the CR
-	for _, container := range cr.Spec.Containers {
-		containers = append(containers, container)
-	}
-
-	return &appsv1.Deployment{
-
-		ObjectMeta: metav1.ObjectMeta{
-			Name:        cr.Spec.Name,
-			Namespace:   cr.Spec.Namespace,
-			Labels:      labels,
-			Annotations: annotations,
-		},
-		Spec: appsv1.DeploymentSpec{
-			Replicas: func() *int32 {
-				if cr.Spec.Replicas != nil {
-					return cr.Spec.Replicas
-				}
-				// Return default of 1 if not specified
-				var val int32 = 1
-				return &val
-			}(),
-			Selector: &metav1.LabelSelector{
-				MatchLabels: labels,
-			},
-			Template: corev1.PodTemplateSpec{
-				ObjectMeta: metav1.ObjectMeta{
-					Labels:      labels,
-					Annotations: annotations,
-				},
-				Spec: corev1.PodSpec{
-					InitContainers:     cr.Spec.InitContainers,
-					Containers:         containers,
-					ServiceAccountName: cr.Spec.ServiceAccountName,
-					ImagePullSecrets:   cr.Spec.ImagePullSecrets,
-					Volumes:            cr.Spec.Volumes,
-				},
-			},
-		},
+	// Verify the revision once it is fully available, rolling it back if needed
+	postDeployRunning, err := r.reconcilePostDeployHook(reqLogger, instance, deployFound)
+	if err != nil {
+		return reconcile.Result{}, err
 	}
-}
 
-// newService returns a service for creating a Helidon application service
-func newService(cr *verrazzanov1beta1.HelidonApp) *corev1.Service {
-	labels := make(map[string]string)
-	labels["app"] = cr.Spec.Name
-
-	port, targetPort := getPorts(cr)
-
-	return &corev1.Service{
-		ObjectMeta: metav1.ObjectMeta{
-			Name:      cr.Spec.Name,
-			Namespace: cr.Spec.Namespace,
-			Labels:    labels,
-		},
-		Spec: corev1.ServiceSpec{
-			Type:     corev1.ServiceTypeClusterIP,
-			Selector: labels,
-			Ports: []corev1.ServicePort{
-				{
-					Name: "http",
-					Port: port,
-					TargetPort: intstr.IntOrString{
-						Type:   intstr.Int,
-						IntVal: targetPort,
-					},
-				},
-			},
-		},
+	// Report the replicas and readiness of the deployment
+	err = r.updateReplicaStatus(reqLogger, instance, deployFound)
+	if err != nil {
+		return reconcile.Result{}, err
 	}
+
+	// Clean up the resources of a previous spec.name or spec.namespace
+	result, err = r.reconcileAppliedResources(reqLogger, instance, deployFound)
+	result = requeueWhileHookRuns(result, preDeployRunning || postDeployRunning)
+	return r.requeueAtNextSchedule(result, nextSchedule), err
 }
 
-// Get the port and targetPort values
-func getPorts(cr *verrazzanov1beta1.HelidonApp) (int32, int32) {
-	// Default port value is 8080
-	var port int32 = 8080
-	if cr.Spec.Port != 0 {
-		port = cr.Spec.Port
-	}
-	// Default target port value is value of port
-	var targetPort = port
-	if cr.Spec.TargetPort != 0 {
-		targetPort = cr.Spec.TargetPort
+// Update the Prometheus annotations in place, returns true if any of them changed
+func updatePrometheusAnnotations(cr *verrazzanov1.HelidonApp, meta *metav1.ObjectMeta) bool {
+	desired := render.PrometheusAnnotations(cr)
+	changed := false
+	for _, key := range render.PrometheusAnnotationKeys {
+		value, found := desired[key]
+		current, exists := meta.Annotations[key]
+		if found == exists && value == current {
+			continue
+		}
+		if found {
+			if meta.Annotations == nil {
+				meta.Annotations = make(map[string]string)
+			}
+			meta.Annotations[key] = value
+		} else {
+			delete(meta.Annotations, key)
+		}
+		changed = true
 	}
-
-	return port, targetPort
+	return changed
 }
 
-// createNamespace returns a namespace resource that may need to be created
-func newNamespace(cr *verrazzanov1beta1.HelidonApp) *corev1.Namespace {
-	labels := make(map[string]string)
-	labels["istio-injection"] = "enabled"
-
-	namespace := &corev1.Namespace{
-		ObjectMeta: metav1.ObjectMeta{
-			Name:   cr.Spec.Namespace,
-			Labels: labels,
-		},
+// doUpdateIfNeeded does an update if needed. While waiting for dependencies, or after the revision was rolled
+// back, updates changing the pod template are held off. A new image or configuration waits for the pre-deploy hook,
+// returns true while its Job runs.
+func (r *ReconcileHelidonApp) doUpdateIfNeeded(reqLogger *zap.SugaredLogger, cr *verrazzanov1.HelidonApp, deployFound *appsv1.Deployment, waiting string) (bool, error) {
+	restartedAt := deployFound.Spec.Template.Annotations[render.RestartedAtAnnotation]
+	updated := deployFound.DeepCopy()
+	updateNeeded, err := r.updateDeployment(cr, updated)
+	if err != nil {
+		return false, err
+	}
+	if updateNeeded && waiting != "" && !equality.Semantic.DeepEqual(updated.Spec.Template, deployFound.Spec.Template) {
+		reqLogger.Infof("Not upgrading Deployment, Name: %s Namespace: %s, %s", deployFound.Name, deployFound.Namespace, waiting)
+		return false, nil
+	}
+	if updateNeeded && isRolledBack(cr, podRevision(&updated.Spec.Template)) &&
+		!equality.Semantic.DeepEqual(updated.Spec.Template, deployFound.Spec.Template) {
+		reqLogger.Infof("Not upgrading Deployment, Name: %s Namespace: %s, the revision was rolled back", deployFound.Name, deployFound.Namespace)
+		return false, nil
+	}
+	// A new image or configuration is only rolled out once spec.hooks.preDeploy succeeded for it
+	if revision := podRevision(&updated.Spec.Template); updateNeeded && revision != podRevision(&deployFound.Spec.Template) {
+		phase, err := r.runPreDeployHook(reqLogger, cr, revision)
+		if phase != verrazzanov1.HookSucceeded || err != nil {
+			return phase == verrazzanov1.HookRunning, err
+		}
 	}
+	updated.DeepCopyInto(deployFound)
 
-	return namespace
-}
+	if updateNeeded {
+		reqLogger.Infof("Updating Deployment, Name: %s Namespace: %s", deployFound.Name, deployFound.Namespace)
+		err := r.client.Update(context.TODO(), deployFound)
+		if err != nil {
+			reqLogger.Errorf("Failed to update Deployment, Name: %s Namespace: %s, Error: %s", deployFound.Name, deployFound.Namespace, err.Error())
+			r.updateStatus(reqLogger, cr, cr.Status.State, "Helidon application deployment update failed: "+err.Error())
+			return false, err
+		}
 
-// createServiceAccount returns a serviceaccount resource that may need to be created
-func newServiceAccount(cr *verrazzanov1beta1.HelidonApp) *corev1.ServiceAccount {
-	return &corev1.ServiceAccount{
-		ObjectMeta: metav1.ObjectMeta{
-			Name:      cr.Spec.ServiceAccountName,
-			Namespace: cr.Spec.Namespace,
-		},
+		if deployFound.Spec.Template.Annotations[render.RestartedAtAnnotation] != restartedAt {
+			now := metav1.Now()
+			cr.Status.LastRestartTime = &now
+			r.updateStatus(reqLogger, cr, "Updated", "Helidon application deployment restarted")
+			return false, nil
+		}
+		r.updateStatus(reqLogger, cr, "Updated", "Helidon application deployment updated")
 	}
+
+	return false, nil
 }
 
-// doUpdateIfNeeded does an update if needed
-func (r *ReconcileHelidonApp) doUpdateIfNeeded(reqLogger *zap.SugaredLogger, cr *verrazzanov1beta1.HelidonApp, deployFound *appsv1.Deployment) error {
+// updateDeployment changes the existing deployment to match the CR, returns true if the deployment changed
+func (r *ReconcileHelidonApp) updateDeployment(cr *verrazzanov1.HelidonApp, deployFound *appsv1.Deployment) (bool, error) {
 	updateNeeded := false
-	if !isReplicasEqual(deployFound.Spec.Replicas, cr.Spec.Replicas) {
-		if cr.Spec.Replicas != nil {
-			deployFound.Spec.Replicas = cr.Spec.Replicas
-		} else {
-			// default of 1 if not specified
-			var val int32 = 1
-			deployFound.Spec.Replicas = &val
-		}
+	replicas, _, _, err := r.evaluateSchedule(cr)
+	if err != nil {
+		return false, err
+	}
+	if !isReplicasEqual(deployFound.Spec.Replicas, &replicas) {
+		deployFound.Spec.Replicas = &replicas
+		updateNeeded = true
+	}
+	if deployFound.Spec.Template.Spec.Containers[0].Image != cr.Spec.Container.Image {
+		deployFound.Spec.Template.Spec.Containers[0].Image = cr.Spec.Container.Image
+		updateNeeded = true
+	}
+	if cr.Spec.Service.Port != 0 && deployFound.Spec.Template.Spec.Containers[0].Ports[0].ContainerPort != cr.Spec.Service.Port {
+		deployFound.Spec.Template.Spec.Containers[0].Ports[0].ContainerPort = cr.Spec.Service.Port
 		updateNeeded = true
 	}
-	if deployFound.Spec.Template.Spec.Containers[0].Image != cr.Spec.Image {
-		deployFound.Spec.Template.Spec.Containers[0].Image = cr.Spec.Image
+	// The main container and the volumes include what the operator generates from the spec
+	desired := render.Deployment(cr)
+	desiredMain := desired.Spec.Template.Spec.Containers[0]
+	if !reflect.DeepEqual(deployFound.Spec.Template.Spec.Containers[0].Env, desiredMain.Env) {
+		deployFound.Spec.Template.Spec.Containers[0].Env = desiredMain.Env
 		updateNeeded = true
 	}
-	if cr.Spec.Port != 0 && deployFound.Spec.Template.Spec.Containers[0].Ports[0].ContainerPort != cr.Spec.Port {
-		deployFound.Spec.Template.Spec.Containers[0].Ports[0].ContainerPort = cr.Spec.Port
+	if !equality.Semantic.DeepEqual(deployFound.Spec.Template.Spec.Containers[0].Resources, desiredMain.Resources) {
+		deployFound.Spec.Template.Spec.Containers[0].Resources = desiredMain.Resources
 		updateNeeded = true
 	}
-	if !reflect.DeepEqual(deployFound.Spec.Template.Spec.Containers[0].Env, cr.Spec.Env) {
-		deployFound.Spec.Template.Spec.Containers[0].Env = cr.Spec.Env
+	if updateRuntimeLabel(cr, &deployFound.Spec.Template.ObjectMeta) {
 		updateNeeded = true
 	}
-	if !reflect.DeepEqual(deployFound.Spec.Template.Spec.ImagePullSecrets, cr.Spec.ImagePullSecrets) {
-		deployFound.Spec.Template.Spec.ImagePullSecrets = cr.Spec.ImagePullSecrets
+	if updateDebugPort(cr, &deployFound.Spec.Template.Spec.Containers[0]) {
+		updateNeeded = true
+	}
+	if !reflect.DeepEqual(deployFound.Spec.Template.Spec.Containers[0].VolumeMounts, desiredMain.VolumeMounts) {
+		deployFound.Spec.Template.Spec.Containers[0].VolumeMounts = desiredMain.VolumeMounts
+		updateNeeded = true
+	}
+	if !reflect.DeepEqual(deployFound.Spec.Template.Spec.Containers[0].LivenessProbe, desiredMain.LivenessProbe) {
+		deployFound.Spec.Template.Spec.Containers[0].LivenessProbe = desiredMain.LivenessProbe
+		updateNeeded = true
+	}
+	if !reflect.DeepEqual(deployFound.Spec.Template.Spec.Containers[0].ReadinessProbe, desiredMain.ReadinessProbe) {
+		deployFound.Spec.Template.Spec.Containers[0].ReadinessProbe = desiredMain.ReadinessProbe
+		updateNeeded = true
+	}
+	if !reflect.DeepEqual(deployFound.Spec.Template.Spec.ImagePullSecrets, cr.Spec.Container.ImagePullSecrets) {
+		deployFound.Spec.Template.Spec.ImagePullSecrets = cr.Spec.Container.ImagePullSecrets
 		updateNeeded = true
 	}
 	if deployFound.Spec.Template.Spec.ServiceAccountName != cr.Spec.ServiceAccountName {
 		deployFound.Spec.Template.Spec.ServiceAccountName = cr.Spec.ServiceAccountName
 		updateNeeded = true
 	}
-	if !reflect.DeepEqual(deployFound.Spec.Template.Spec.InitContainers, cr.Spec.InitContainers) {
-		deployFound.Spec.Template.Spec.InitContainers = cr.Spec.InitContainers
+	if !reflect.DeepEqual(deployFound.Spec.Template.Spec.InitContainers, desired.Spec.Template.Spec.InitContainers) {
+		deployFound.Spec.Template.Spec.InitContainers = desired.Spec.Template.Spec.InitContainers
+		updateNeeded = true
+	}
+	if !isVolumesEqual(deployFound.Spec.Template.Spec.Volumes, desired.Spec.Template.Spec.Volumes) {
+		deployFound.Spec.Template.Spec.Volumes = desired.Spec.Template.Spec.Volumes
+		updateNeeded = true
+	}
+	if updatePrometheusAnnotations(cr, &deployFound.Spec.Template.ObjectMeta) {
+		updatePrometheusAnnotations(cr, &deployFound.ObjectMeta)
+		updateNeeded = true
+	}
+	if updateRestartedAt(cr, &deployFound.Spec.Template.ObjectMeta) {
 		updateNeeded = true
 	}
-	if len(cr.Spec.Volumes) != len(deployFound.Spec.Template.Spec.Volumes) {
-		var volumes []corev1.Volume
-		for _, crVolume := range cr.Spec.Volumes {
-			volumes = append(volumes, crVolume)
+	hash, err := r.configHash(cr)
+	if err != nil {
+		return false, err
+	}
+	if updateConfigHash(&deployFound.Spec.Template.ObjectMeta, hash) {
+		updateNeeded = true
+	}
+	if !hasOwnerLabels(cr, deployFound) {
+		if err := setOwnership(cr, deployFound, r.scheme); err != nil {
+			return false, err
 		}
-		deployFound.Spec.Template.Spec.Volumes = volumes
 		updateNeeded = true
 	}
 	if len(cr.Spec.Containers)+1 != len(deployFound.Spec.Template.Spec.Containers) {
@@ -418,19 +653,102 @@ func (r *ReconcileHelidonApp) doUpdateIfNeeded(reqLogger *zap.SugaredLogger, cr
 		updateNeeded = true
 	}
 
-	if updateNeeded {
-		reqLogger.Infof("Updating Deployment, Name: %s Namespace: %s", deployFound.Name, deployFound.Namespace)
-		err := r.client.Update(context.TODO(), deployFound)
-		if err != nil {
-			reqLogger.Errorf("Failed to update Deployment, Name: %s Namespace: %s, Error: %s", deployFound.Name, deployFound.Namespace, err.Error())
-			r.updateStatus(reqLogger, cr, cr.Status.State, "Helidon application deployment update failed: "+err.Error())
-			return err
+	return updateNeeded, nil
+}
+
+// updateService changes the existing service to match the CR, returns true if the service changed
+func (r *ReconcileHelidonApp) updateService(cr *verrazzanov1.HelidonApp, serviceFound *corev1.Service) (bool, error) {
+	updateNeeded := false
+	if cr.Spec.Service.Port != 0 && cr.Spec.Service.Port != serviceFound.Spec.Ports[0].Port {
+		serviceFound.Spec.Ports[0].Port = cr.Spec.Service.Port
+		serviceFound.Spec.Ports[0].TargetPort = intstr.IntOrString{
+			Type:   intstr.Int,
+			IntVal: cr.Spec.Service.Port,
+		}
+		updateNeeded = true
+	}
+	if !hasOwnerLabels(cr, serviceFound) {
+		if err := setOwnership(cr, serviceFound, r.scheme); err != nil {
+			return false, err
+		}
+		updateNeeded = true
+	}
+	return updateNeeded, nil
+}
+
+// Check if the existing and target volumes are the same. The volumes of existing deployments hold the defaults
+// set by Kubernetes, so both are compared with the defaults applied.
+func isVolumesEqual(existing []corev1.Volume, target []corev1.Volume) bool {
+	if len(existing) != len(target) {
+		return false
+	}
+	for i := range existing {
+		if !equality.Semantic.DeepEqual(defaultVolume(existing[i]), defaultVolume(target[i])) {
+			return false
 		}
+	}
+	return true
+}
 
-		r.updateStatus(reqLogger, cr, "Updated", "Helidon application deployment updated")
+// defaultVolumeMode is the mode Kubernetes sets on the files of ConfigMap, Secret, downward API and projected
+// volumes without a defaultMode
+const defaultVolumeMode int32 = 0644
+
+// defaultServiceAccountTokenExpiration is the expiration Kubernetes sets on projected service account tokens
+const defaultServiceAccountTokenExpiration int64 = 3600
+
+// defaultVolume returns a copy of the volume with the defaults Kubernetes sets on the volume sources
+func defaultVolume(volume corev1.Volume) corev1.Volume {
+	v := volume.DeepCopy()
+	source := &v.VolumeSource
+	if reflect.DeepEqual(*source, corev1.VolumeSource{}) {
+		source.EmptyDir = &corev1.EmptyDirVolumeSource{}
+	}
+	if source.HostPath != nil && source.HostPath.Type == nil {
+		hostPathType := corev1.HostPathUnset
+		source.HostPath.Type = &hostPathType
+	}
+	if source.ConfigMap != nil {
+		defaultMode(&source.ConfigMap.DefaultMode)
+	}
+	if source.Secret != nil {
+		defaultMode(&source.Secret.DefaultMode)
+	}
+	if source.DownwardAPI != nil {
+		defaultMode(&source.DownwardAPI.DefaultMode)
+		defaultDownwardAPIItems(source.DownwardAPI.Items)
 	}
+	if source.Projected != nil {
+		defaultMode(&source.Projected.DefaultMode)
+		for i := range source.Projected.Sources {
+			projection := &source.Projected.Sources[i]
+			if projection.DownwardAPI != nil {
+				defaultDownwardAPIItems(projection.DownwardAPI.Items)
+			}
+			if projection.ServiceAccountToken != nil && projection.ServiceAccountToken.ExpirationSeconds == nil {
+				expiration := defaultServiceAccountTokenExpiration
+				projection.ServiceAccountToken.ExpirationSeconds = &expiration
+			}
+		}
+	}
+	return *v
+}
 
-	return nil
+// defaultMode sets a file mode left unset to the default mode of volumes
+func defaultMode(mode **int32) {
+	if *mode == nil {
+		m := defaultVolumeMode
+		*mode = &m
+	}
+}
+
+// defaultDownwardAPIItems sets the API version of the field references of downward API items
+func defaultDownwardAPIItems(items []corev1.DownwardAPIVolumeFile) {
+	for i := range items {
+		if items[i].FieldRef != nil && items[i].FieldRef.APIVersion == "" {
+			items[i].FieldRef.APIVersion = "v1"
+		}
+	}
 }
 
 // Check if the existing and target replicas are same
@@ -458,7 +776,7 @@ func isReplicasEqual(existing *int32, target *int32) bool {
 }
 
 // Update the status for the CR
-func (r *ReconcileHelidonApp) updateStatus(reqLogger *zap.SugaredLogger, cr *verrazzanov1beta1.HelidonApp, state string, message string) error {
+func (r *ReconcileHelidonApp) updateStatus(reqLogger *zap.SugaredLogger, cr *verrazzanov1.HelidonApp, state string, message string) error {
 	cr.Status.State = state
 	cr.Status.LastActionMessage = message
 	t := time.Now().UTC()
@@ -475,3 +793,12 @@ func (r *ReconcileHelidonApp) updateStatus(reqLogger *zap.SugaredLogger, cr *ver
 	return nil
 
 }
+
+// updateStatusIfChanged updates the status unless it already has the state and message, so that waiting for a
+// resource doesn't update the HelidonApp on every poll
+func (r *ReconcileHelidonApp) updateStatusIfChanged(reqLogger *zap.SugaredLogger, cr *verrazzanov1.HelidonApp, state string, message string) error {
+	if cr.Status.State == state && cr.Status.LastActionMessage == message {
+		return nil
+	}
+	return r.updateStatus(reqLogger, cr, state, message)
+}
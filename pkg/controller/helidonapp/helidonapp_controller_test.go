@@ -10,8 +10,10 @@ import (
 	"k8s.io/apimachinery/pkg/util/intstr"
 
 	"github.com/stretchr/testify/assert"
-	vz "github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1beta1"
+	vz "github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1"
+	"github.com/verrazzano/verrazzano-helidon-app-operator/pkg/render"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 )
 
 func TestNewService(t *testing.T) {
@@ -20,7 +22,7 @@ func TestNewService(t *testing.T) {
 	app := vz.HelidonApp{}
 	app.Spec.Name = appName
 	app.Spec.Namespace = appNs
-	svc := newService(&app)
+	svc := render.Service(&app)
 	assert.Equal(t, corev1.ServiceTypeClusterIP, svc.Spec.Type, "Expected ServiceTypeClusterIP")
 	assert.Equal(t, 1, len(svc.Spec.Ports), "Expected 1 svc.Spec.Port")
 	port := svc.Spec.Ports[0]
@@ -28,9 +30,9 @@ func TestNewService(t *testing.T) {
 	assert.Equal(t, intstr.FromInt(8080), port.TargetPort, "Expected default targetPort")
 	expectedPort := int32(8010)
 	expectedTargetPort := int32(8011)
-	app.Spec.Port = expectedPort
-	app.Spec.TargetPort = expectedTargetPort
-	svc = newService(&app)
+	app.Spec.Service.Port = expectedPort
+	app.Spec.Service.TargetPort = expectedTargetPort
+	svc = render.Service(&app)
 	t.Log("Generated Service", svc)
 	assert.Equal(t, appName, svc.Name, "Expected Name")
 	assert.Equal(t, appNs, svc.Namespace, "Expected Namespace")
@@ -43,9 +45,9 @@ func TestNewService(t *testing.T) {
 	// Verify that target port is set to a non-default port if the target port is not specified
 	expectedPort = int32(8079)
 	expectedTargetPort = int32(8079)
-	app.Spec.Port = expectedPort
-	app.Spec.TargetPort = 0
-	svc = newService(&app)
+	app.Spec.Service.Port = expectedPort
+	app.Spec.Service.TargetPort = 0
+	svc = render.Service(&app)
 	assert.Equal(t, 1, len(svc.Spec.Ports), "Expected 1 svc.Spec.Port")
 	port = svc.Spec.Ports[0]
 	assert.Equal(t, expectedPort, port.Port, "Expected default port")
@@ -60,11 +62,11 @@ func TestNewDeploymentWithVolumes(t *testing.T) {
 	app := vz.HelidonApp{}
 	app.Spec.Name = appName
 	app.Spec.Namespace = appNs
-	deploy := newDeployment(&app)
+	deploy := render.Deployment(&app)
 	assert.Equal(t, 0, len(deploy.Spec.Template.Spec.Volumes), "Expected 0 volumes for deployment")
 
 	app.Spec.Volumes = createVolumes()
-	deploy = newDeployment(&app)
+	deploy = render.Deployment(&app)
 	assert.Equal(t, 1, len(deploy.Spec.Template.Spec.Volumes), "Expected 1 volume for deployment")
 	name := "varlog"
 	assert.Equal(t, name, deploy.Spec.Template.Spec.Volumes[0].Name, fmt.Sprintf("Expected volume name to be %s", name))
@@ -80,14 +82,14 @@ func TestNewDeploymentWithContainers(t *testing.T) {
 	app := vz.HelidonApp{}
 	app.Spec.Name = appName
 	app.Spec.Namespace = appNs
-	app.Spec.Image = appImage
-	deploy := newDeployment(&app)
+	app.Spec.Container.Image = appImage
+	deploy := render.Deployment(&app)
 	assert.Equal(t, 1, len(deploy.Spec.Template.Spec.Containers), "Expected 1 container for deployment")
 	assert.Equal(t, appName, deploy.Spec.Template.Spec.Containers[0].Name, fmt.Sprintf("Expected name to be %s", appName))
 	assert.Equal(t, appImage, deploy.Spec.Template.Spec.Containers[0].Image, fmt.Sprintf("Expected image to be %s", appImage))
 
 	app.Spec.Containers = createContainers()
-	deploy = newDeployment(&app)
+	deploy = render.Deployment(&app)
 	assert.Equal(t, 2, len(deploy.Spec.Template.Spec.Containers), "Expected 2 containers for deployment")
 	assert.Equal(t, appName, deploy.Spec.Template.Spec.Containers[0].Name, fmt.Sprintf("Expected name to be %s", appName))
 	assert.Equal(t, appImage, deploy.Spec.Template.Spec.Containers[0].Image, fmt.Sprintf("Expected image to be %s", appImage))
@@ -95,6 +97,60 @@ func TestNewDeploymentWithContainers(t *testing.T) {
 	assert.Equal(t, "sidecar-image", deploy.Spec.Template.Spec.Containers[1].Image, "Expected name to be sidecar-image")
 }
 
+// Test that volumes are compared with the defaults set by Kubernetes applied
+func TestIsVolumesEqual(t *testing.T) {
+	target := []corev1.Volume{
+		{Name: "config", VolumeSource: corev1.VolumeSource{ConfigMap: &corev1.ConfigMapVolumeSource{
+			LocalObjectReference: corev1.LocalObjectReference{Name: "config"}}}},
+		{Name: "scratch"},
+		{Name: "info", VolumeSource: corev1.VolumeSource{DownwardAPI: &corev1.DownwardAPIVolumeSource{
+			Items: []corev1.DownwardAPIVolumeFile{{Path: "labels", FieldRef: &corev1.ObjectFieldSelector{FieldPath: "metadata.labels"}}}}}},
+		{Name: "token", VolumeSource: corev1.VolumeSource{Projected: &corev1.ProjectedVolumeSource{
+			Sources: []corev1.VolumeProjection{{ServiceAccountToken: &corev1.ServiceAccountTokenProjection{Path: "token"}}}}}},
+	}
+	mode := int32(0644)
+	expiration := int64(3600)
+	existing := []corev1.Volume{
+		{Name: "config", VolumeSource: corev1.VolumeSource{ConfigMap: &corev1.ConfigMapVolumeSource{
+			LocalObjectReference: corev1.LocalObjectReference{Name: "config"}, DefaultMode: &mode}}},
+		{Name: "scratch", VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}}},
+		{Name: "info", VolumeSource: corev1.VolumeSource{DownwardAPI: &corev1.DownwardAPIVolumeSource{DefaultMode: &mode,
+			Items: []corev1.DownwardAPIVolumeFile{{Path: "labels", FieldRef: &corev1.ObjectFieldSelector{APIVersion: "v1", FieldPath: "metadata.labels"}}}}}},
+		{Name: "token", VolumeSource: corev1.VolumeSource{Projected: &corev1.ProjectedVolumeSource{DefaultMode: &mode,
+			Sources: []corev1.VolumeProjection{{ServiceAccountToken: &corev1.ServiceAccountTokenProjection{Path: "token", ExpirationSeconds: &expiration}}}}}},
+	}
+	assert.True(t, isVolumesEqual(existing, target), "Expected volumes with the Kubernetes defaults to be equal")
+
+	changed := []corev1.Volume{}
+	for i := range target {
+		changed = append(changed, *target[i].DeepCopy())
+	}
+	changed[0].ConfigMap.Items = []corev1.KeyToPath{{Key: "application.yaml", Path: "application.yaml"}}
+	assert.False(t, isVolumesEqual(existing, changed), "Expected changed items to be different")
+
+	changed[0].ConfigMap.Items = nil
+	readOnly := int32(0400)
+	changed[0].ConfigMap.DefaultMode = &readOnly
+	assert.False(t, isVolumesEqual(existing, changed), "Expected changed defaultMode to be different")
+
+	changed[0].ConfigMap.DefaultMode = nil
+	sizeLimit := resource.MustParse("1Gi")
+	changed[1].EmptyDir = &corev1.EmptyDirVolumeSource{SizeLimit: &sizeLimit}
+	assert.False(t, isVolumesEqual(existing, changed), "Expected changed emptyDir sizeLimit to be different")
+
+	changed[1].EmptyDir = nil
+	changed[2].DownwardAPI.Items[0].FieldRef.FieldPath = "metadata.annotations"
+	assert.False(t, isVolumesEqual(existing, changed), "Expected changed downwardAPI items to be different")
+
+	changed[2].DownwardAPI.Items[0].FieldRef.FieldPath = "metadata.labels"
+	changed[3].Projected.Sources = append(changed[3].Projected.Sources, corev1.VolumeProjection{
+		Secret: &corev1.SecretProjection{LocalObjectReference: corev1.LocalObjectReference{Name: "secret"}}})
+	assert.False(t, isVolumesEqual(existing, changed), "Expected changed projected sources to be different")
+
+	changed[3].Projected.Sources = changed[3].Projected.Sources[:1]
+	assert.True(t, isVolumesEqual(existing, changed), "Expected volumes changed back to be equal")
+}
+
 func createVolumes() []corev1.Volume {
 	return []corev1.Volume{
 		{
@@ -116,3 +172,26 @@ func createContainers() []corev1.Container {
 		},
 	}
 }
+
+// Test the Prometheus annotations of the deployment
+func TestNewDeploymentPrometheusAnnotations(t *testing.T) {
+	app := vz.HelidonApp{}
+	app.Spec.Name = "myHelidonApp"
+	app.Spec.Namespace = "myns"
+	app.Spec.Service.TargetPort = 8011
+	deploy := render.Deployment(&app)
+	annotations := deploy.Spec.Template.Annotations
+	assert.Equal(t, "true", annotations["prometheus.io/scrape"])
+	assert.Equal(t, "8011", annotations["prometheus.io/port"])
+	assert.Equal(t, "/metrics", annotations["prometheus.io/path"], "Expected default metrics path")
+
+	app.Spec.Observability.Metrics.Path = "/observe/metrics"
+	deploy = render.Deployment(&app)
+	assert.Equal(t, "/observe/metrics", deploy.Spec.Template.Annotations["prometheus.io/path"])
+
+	enabled := false
+	app.Spec.Observability.Metrics.Enabled = &enabled
+	assert.True(t, updatePrometheusAnnotations(&app, &deploy.Spec.Template.ObjectMeta), "Expected annotations to change")
+	assert.Equal(t, 0, len(deploy.Spec.Template.Annotations), "Expected Prometheus annotations to be removed")
+	assert.False(t, updatePrometheusAnnotations(&app, &deploy.Spec.Template.ObjectMeta), "Expected no change")
+}
@@ -0,0 +1,256 @@
+// Copyright (c) 2020, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package helidonapp
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+
+	"go.uber.org/zap"
+
+	verrazzanov1 "github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1"
+	"github.com/verrazzano/verrazzano-helidon-app-operator/pkg/render"
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// podRevision returns the hook revision of the image and configuration of a pod template
+func podRevision(template *corev1.PodTemplateSpec) string {
+	return render.HookRevision(template.Spec.Containers[0].Image, template.Annotations[ConfigHashAnnotation])
+}
+
+// hookPhase returns the phase of the Job of a hook
+func hookPhase(job *batchv1.Job) verrazzanov1.HookPhase {
+	for _, condition := range job.Status.Conditions {
+		if condition.Status != corev1.ConditionTrue {
+			continue
+		}
+		switch condition.Type {
+		case batchv1.JobComplete:
+			return verrazzanov1.HookSucceeded
+		case batchv1.JobFailed:
+			return verrazzanov1.HookFailed
+		}
+	}
+	return verrazzanov1.HookRunning
+}
+
+// runHook returns the status of the Job of a hook for a revision, the Job is created once per revision.
+// Completed Jobs beyond the history limit are deleted once the Job completed.
+func (r *ReconcileHelidonApp) runHook(reqLogger *zap.SugaredLogger, cr *verrazzanov1.HelidonApp, hook string, spec *verrazzanov1.HookSpec, revision string) (*verrazzanov1.HookStatus, error) {
+	job, err := r.ensureHookJob(reqLogger, cr, hook, spec, revision)
+	if err != nil {
+		return nil, err
+	}
+	status := &verrazzanov1.HookStatus{
+		Job:      job.Name,
+		Revision: revision,
+		Phase:    hookPhase(job),
+		Logs:     fmt.Sprintf("kubectl logs -n %s job/%s", job.Namespace, job.Name),
+	}
+	if status.Phase != verrazzanov1.HookRunning {
+		if err := r.pruneHookJobs(reqLogger, cr, hook, job.Name); err != nil {
+			return nil, err
+		}
+	}
+	return status, nil
+}
+
+// updateHookStatus updates the status when the state and message, or the status of a hook, changed
+func (r *ReconcileHelidonApp) updateHookStatus(reqLogger *zap.SugaredLogger, cr *verrazzanov1.HelidonApp, hookChanged bool, state string, message string) error {
+	if cr.Status.State != state || cr.Status.LastActionMessage != message {
+		return r.updateStatus(reqLogger, cr, state, message)
+	}
+	if !hookChanged {
+		return nil
+	}
+	err := r.client.Status().Update(context.TODO(), cr)
+	if err != nil {
+		reqLogger.Errorf("Failed to update HelidonApp status, Name: %s Namespace: %s, Error: %s", cr.Name, cr.Namespace, err.Error())
+	}
+	return err
+}
+
+// requeueWhileHookRuns polls while the Job of a hook runs. Its completion only triggers a reconcile when the
+// operator watches spec.namespace.
+func requeueWhileHookRuns(result reconcile.Result, running bool) reconcile.Result {
+	if running && (result.RequeueAfter == 0 && !result.Requeue || result.RequeueAfter > readyPollInterval) {
+		result.RequeueAfter = readyPollInterval
+	}
+	return result
+}
+
+// runPreDeployHook runs spec.hooks.preDeploy for the revision about to be rolled out. Returns the phase of the
+// Job, Succeeded if there is no hook. A failed Job blocks the rollout of the revision until it is deleted.
+func (r *ReconcileHelidonApp) runPreDeployHook(reqLogger *zap.SugaredLogger, cr *verrazzanov1.HelidonApp, revision string) (verrazzanov1.HookPhase, error) {
+	if cr.Spec.Hooks == nil || cr.Spec.Hooks.PreDeploy == nil {
+		return verrazzanov1.HookSucceeded, nil
+	}
+	hookStatus, err := r.runHook(reqLogger, cr, render.PreDeployHook, cr.Spec.Hooks.PreDeploy, revision)
+	if err != nil {
+		return "", err
+	}
+	changed := !reflect.DeepEqual(hookStatus, cr.Status.PreDeployHook)
+	cr.Status.PreDeployHook = hookStatus
+
+	state, message := cr.Status.State, cr.Status.LastActionMessage
+	if hookStatus.Phase == verrazzanov1.HookFailed {
+		reqLogger.Infof("Pre-deploy hook failed, Job: %s Namespace: %s", hookStatus.Job, cr.Spec.Namespace)
+		state = "Failed"
+		message = fmt.Sprintf("Helidon application pre-deploy hook failed, the rollout is blocked. See the logs of Job %s/%s",
+			cr.Spec.Namespace, hookStatus.Job)
+	}
+	if err := r.updateHookStatus(reqLogger, cr, changed, state, message); err != nil {
+		return "", err
+	}
+	return hookStatus.Phase, nil
+}
+
+// rollbackPolicy returns the rollback policy of spec.hooks
+func rollbackPolicy(cr *verrazzanov1.HelidonApp) verrazzanov1.RollbackPolicy {
+	if cr.Spec.Hooks == nil || cr.Spec.Hooks.RollbackPolicy == "" {
+		return verrazzanov1.RollbackAutomatic
+	}
+	return cr.Spec.Hooks.RollbackPolicy
+}
+
+// isRolledBack checks if a revision was rolled back after its post-deploy Job failed, it isn't rolled out again
+func isRolledBack(cr *verrazzanov1.HelidonApp, revision string) bool {
+	condition := findCondition(&cr.Status, verrazzanov1.ConditionRolledBack)
+	hook := cr.Status.PostDeployHook
+	return condition != nil && condition.Status == corev1.ConditionTrue &&
+		hook != nil && hook.Revision == revision && hook.Phase == verrazzanov1.HookFailed
+}
+
+// reconcilePostDeployHook runs spec.hooks.postDeploy once the revision of the Deployment is fully available.
+// When the Job succeeds the revision becomes the last known good one, when it fails the rollback policy
+// restores the pod template of the last known good revision. Returns true while the Job runs.
+func (r *ReconcileHelidonApp) reconcilePostDeployHook(reqLogger *zap.SugaredLogger, cr *verrazzanov1.HelidonApp, deployment *appsv1.Deployment) (bool, error) {
+	if cr.Spec.Hooks == nil || cr.Spec.Hooks.PostDeploy == nil {
+		return false, nil
+	}
+	revision := podRevision(&deployment.Spec.Template)
+	good := cr.Status.LastKnownGood
+	if good != nil && good.Revision == revision {
+		return false, nil
+	}
+	if deployment.Spec.Replicas != nil && *deployment.Spec.Replicas == 0 || !isDeploymentReady(deployment) {
+		return false, nil
+	}
+
+	hookStatus, err := r.runHook(reqLogger, cr, render.PostDeployHook, cr.Spec.Hooks.PostDeploy, revision)
+	if err != nil {
+		return false, err
+	}
+	changed := !reflect.DeepEqual(hookStatus, cr.Status.PostDeployHook)
+	cr.Status.PostDeployHook = hookStatus
+
+	switch {
+	case hookStatus.Phase == verrazzanov1.HookRunning:
+		return true, r.updateHookStatus(reqLogger, cr, changed, cr.Status.State, cr.Status.LastActionMessage)
+
+	case hookStatus.Phase == verrazzanov1.HookSucceeded:
+		template, err := json.Marshal(deployment.Spec.Template)
+		if err != nil {
+			return false, err
+		}
+		cr.Status.LastKnownGood = &verrazzanov1.KnownGoodRevision{Revision: revision, Template: runtime.RawExtension{Raw: template}}
+		removeCondition(&cr.Status, verrazzanov1.ConditionRolledBack)
+		return false, r.updateStatus(reqLogger, cr, cr.Status.State, "Helidon application post-deploy hook succeeded")
+
+	case good == nil || rollbackPolicy(cr) == verrazzanov1.RollbackNone:
+		reqLogger.Infof("Post-deploy hook failed, Job: %s Namespace: %s", hookStatus.Job, cr.Spec.Namespace)
+		return false, r.updateHookStatus(reqLogger, cr, changed, "Failed",
+			fmt.Sprintf("Helidon application post-deploy hook failed. See the logs of Job %s/%s", cr.Spec.Namespace, hookStatus.Job))
+	}
+
+	// Restore the last known good pod template, doUpdateIfNeeded doesn't roll the failed revision out again
+	reqLogger.Infof("Post-deploy hook failed, rolling back Deployment, Name: %s Namespace: %s Revision: %s",
+		deployment.Name, deployment.Namespace, good.Revision)
+	template := corev1.PodTemplateSpec{}
+	if err := json.Unmarshal(good.Template.Raw, &template); err != nil {
+		return false, err
+	}
+	deployment.Spec.Template = template
+	if err := r.client.Update(context.TODO(), deployment); err != nil {
+		reqLogger.Errorf("Failed to roll back Deployment, Name: %s Namespace: %s, Error: %s", deployment.Name, deployment.Namespace, err.Error())
+		return false, err
+	}
+	setCondition(&cr.Status, verrazzanov1.ConditionRolledBack, corev1.ConditionTrue, "PostDeployHookFailed",
+		fmt.Sprintf("Post-deploy hook Job %s/%s failed, restored revision %s", cr.Spec.Namespace, hookStatus.Job, good.Revision))
+	return false, r.updateStatus(reqLogger, cr, "RolledBack",
+		fmt.Sprintf("Helidon application rolled back to revision %s, the post-deploy hook failed. See the logs of Job %s/%s",
+			good.Revision, cr.Spec.Namespace, hookStatus.Job))
+}
+
+// ensureHookJob returns the Job of a hook for a revision, created if it doesn't exist
+func (r *ReconcileHelidonApp) ensureHookJob(reqLogger *zap.SugaredLogger, cr *verrazzanov1.HelidonApp, hook string, spec *verrazzanov1.HookSpec, revision string) (*batchv1.Job, error) {
+	job := render.HookJob(cr, hook, spec, revision)
+	found := &batchv1.Job{}
+	err := r.client.Get(context.TODO(), types.NamespacedName{Namespace: job.Namespace, Name: job.Name}, found)
+	if err == nil {
+		return found, nil
+	} else if !errors.IsNotFound(err) {
+		return nil, err
+	}
+	if err := setOwnership(cr, job, r.scheme); err != nil {
+		return nil, err
+	}
+	reqLogger.Infof("Creating a new %s hook Job, Name: %s Namespace: %s", hook, job.Name, job.Namespace)
+	if err := r.client.Create(context.TODO(), job); err != nil {
+		reqLogger.Errorf("Failed to create Job, Name: %s Namespace: %s, Error: %s", job.Name, job.Namespace, err.Error())
+		return nil, err
+	}
+	return job, nil
+}
+
+// hookHistoryLimit returns the number of completed hook Jobs kept
+func hookHistoryLimit(cr *verrazzanov1.HelidonApp) int {
+	if cr.Spec.Hooks == nil || cr.Spec.Hooks.HistoryLimit == nil {
+		return render.DefaultHookHistoryLimit
+	}
+	return int(*cr.Spec.Hooks.HistoryLimit)
+}
+
+// pruneHookJobs deletes the oldest completed Jobs of a hook beyond the history limit, with their pods. The Job
+// of the current revision is kept.
+func (r *ReconcileHelidonApp) pruneHookJobs(reqLogger *zap.SugaredLogger, cr *verrazzanov1.HelidonApp, hook string, current string) error {
+	labels := ownerLabels(cr)
+	labels[render.HookLabel] = hook
+	jobs := &batchv1.JobList{}
+	if err := r.client.List(context.TODO(), jobs, client.InNamespace(cr.Spec.Namespace), client.MatchingLabels(labels)); err != nil {
+		return err
+	}
+	var completed []batchv1.Job
+	for _, job := range jobs.Items {
+		if job.Name != current && hookPhase(&job) != verrazzanov1.HookRunning {
+			completed = append(completed, job)
+		}
+	}
+	sort.Slice(completed, func(i, j int) bool {
+		if completed[i].CreationTimestamp.Equal(&completed[j].CreationTimestamp) {
+			return completed[i].Name > completed[j].Name
+		}
+		return completed[j].CreationTimestamp.Before(&completed[i].CreationTimestamp)
+	})
+	for i := hookHistoryLimit(cr); i < len(completed); i++ {
+		job := &completed[i]
+		reqLogger.Infof("Deleting completed %s hook Job, Name: %s Namespace: %s", hook, job.Name, job.Namespace)
+		err := r.client.Delete(context.TODO(), job, client.PropagationPolicy(metav1.DeletePropagationBackground))
+		if err != nil && !errors.IsNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,225 @@
+// Copyright (c) 2020, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package helidonapp
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	vz "github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1"
+	"github.com/verrazzano/verrazzano-helidon-app-operator/pkg/render"
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// completeTestJob sets the Complete or Failed condition of a Job
+func completeTestJob(t *testing.T, c client.Client, job *batchv1.Job, conditionType batchv1.JobConditionType) {
+	job.Status.Conditions = []batchv1.JobCondition{{Type: conditionType, Status: corev1.ConditionTrue}}
+	assert.NoError(t, c.Status().Update(context.TODO(), job))
+}
+
+// listTestHookJobs lists the Jobs of a hook
+func listTestHookJobs(t *testing.T, c client.Client, hook string) []batchv1.Job {
+	jobs := &batchv1.JobList{}
+	assert.NoError(t, c.List(context.TODO(), jobs, client.MatchingLabels{render.HookLabel: hook}))
+	return jobs.Items
+}
+
+// Test the Deployment is only created and updated to a new image once the pre-deploy Job succeeded for it
+func TestReconcilePreDeployHook(t *testing.T) {
+	s := newTestScheme(t)
+	app := newTestApp("orders", "myns", "myns")
+	historyLimit := int32(0)
+	app.Spec.Hooks = &vz.HooksSpec{PreDeploy: &vz.HookSpec{Command: []string{"flyway", "migrate"}}, HistoryLimit: &historyLimit}
+	c := fake.NewFakeClientWithScheme(s, app)
+	r := &ReconcileHelidonApp{client: c, scheme: s}
+	request := reconcile.Request{NamespacedName: types.NamespacedName{Namespace: "myns", Name: "orders"}}
+
+	// The first reconcile creates the namespace, the next ones poll the Job, which may not be watched
+	for i := 0; i < 3; i++ {
+		result, err := r.Reconcile(request)
+		assert.NoError(t, err)
+		if i > 0 {
+			assert.Equal(t, readyPollInterval, result.RequeueAfter)
+		}
+	}
+	jobs := listTestHookJobs(t, c, render.PreDeployHook)
+	if !assert.Len(t, jobs, 1) {
+		return
+	}
+	first := jobs[0]
+	assert.Equal(t, []string{"flyway", "migrate"}, first.Spec.Template.Spec.Containers[0].Command)
+	assert.Equal(t, "orders", first.Labels[OwnerNameLabel])
+	found := &vz.HelidonApp{}
+	assert.NoError(t, c.Get(context.TODO(), request.NamespacedName, found))
+	assert.Equal(t, &vz.HookStatus{Job: first.Name, Revision: render.HookRevision("myimage", ""), Phase: vz.HookRunning,
+		Logs: "kubectl logs -n myns job/" + first.Name}, found.Status.PreDeployHook)
+	assert.True(t, errors.IsNotFound(c.Get(context.TODO(), request.NamespacedName, &appsv1.Deployment{})))
+
+	completeTestJob(t, c, &first, batchv1.JobComplete)
+	for i := 0; i < 2; i++ {
+		_, err := r.Reconcile(request)
+		assert.NoError(t, err)
+	}
+	deployment := &appsv1.Deployment{}
+	assert.NoError(t, c.Get(context.TODO(), request.NamespacedName, deployment))
+
+	// A new image runs a new Job, its failure blocks the rollout
+	found = &vz.HelidonApp{}
+	assert.NoError(t, c.Get(context.TODO(), request.NamespacedName, found))
+	found.Spec.Container.Image = "myimage:2"
+	assert.NoError(t, c.Update(context.TODO(), found))
+	result, err := r.Reconcile(request)
+	assert.NoError(t, err)
+	assert.Equal(t, readyPollInterval, result.RequeueAfter)
+	jobs = listTestHookJobs(t, c, render.PreDeployHook)
+	assert.Len(t, jobs, 2)
+	second := &batchv1.Job{}
+	secondName := render.HookJobName(found, render.PreDeployHook, render.HookRevision("myimage:2", ""))
+	assert.NoError(t, c.Get(context.TODO(), types.NamespacedName{Namespace: "myns", Name: secondName}, second))
+	completeTestJob(t, c, second, batchv1.JobFailed)
+	result, err = r.Reconcile(request)
+	assert.NoError(t, err)
+	assert.Zero(t, result.RequeueAfter, "Expected a failed Job not to be polled")
+	found = &vz.HelidonApp{}
+	assert.NoError(t, c.Get(context.TODO(), request.NamespacedName, found))
+	assert.Equal(t, "Failed", found.Status.State)
+	assert.Equal(t, "Helidon application pre-deploy hook failed, the rollout is blocked. See the logs of Job myns/"+secondName,
+		found.Status.LastActionMessage)
+	assert.Equal(t, vz.HookFailed, found.Status.PreDeployHook.Phase)
+	deployment = &appsv1.Deployment{}
+	assert.NoError(t, c.Get(context.TODO(), request.NamespacedName, deployment))
+	assert.Equal(t, "myimage", deployment.Spec.Template.Spec.Containers[0].Image)
+
+	// The completed Job of the previous image is beyond the history limit
+	jobs = listTestHookJobs(t, c, render.PreDeployHook)
+	if assert.Len(t, jobs, 1) {
+		assert.Equal(t, secondName, jobs[0].Name)
+	}
+}
+
+// Test a revision failing its post-deploy Job is rolled back to the last known good pod template, and isn't
+// rolled out again
+func TestReconcilePostDeployHookRollback(t *testing.T) {
+	s := newTestScheme(t)
+	app := newTestApp("orders", "myns", "myns")
+	app.Spec.Hooks = &vz.HooksSpec{PostDeploy: &vz.HookSpec{Command: []string{"smoke-test"}}}
+	c := fake.NewFakeClientWithScheme(s, app)
+	r := &ReconcileHelidonApp{client: c, scheme: s}
+	request := reconcile.Request{NamespacedName: types.NamespacedName{Namespace: "myns", Name: "orders"}}
+	reconcileTimes := func(n int) {
+		for i := 0; i < n; i++ {
+			_, err := r.Reconcile(request)
+			assert.NoError(t, err)
+		}
+	}
+	getApp := func() *vz.HelidonApp {
+		found := &vz.HelidonApp{}
+		assert.NoError(t, c.Get(context.TODO(), request.NamespacedName, found))
+		return found
+	}
+	getDeployment := func() *appsv1.Deployment {
+		deployment := &appsv1.Deployment{}
+		assert.NoError(t, c.Get(context.TODO(), request.NamespacedName, deployment))
+		return deployment
+	}
+	getJob := func(image string) *batchv1.Job {
+		job := &batchv1.Job{}
+		name := render.HookJobName(app, render.PostDeployHook, render.HookRevision(image, ""))
+		assert.NoError(t, c.Get(context.TODO(), types.NamespacedName{Namespace: "myns", Name: name}, job))
+		return job
+	}
+	setImage := func(image string) {
+		found := getApp()
+		found.Spec.Container.Image = image
+		assert.NoError(t, c.Update(context.TODO(), found))
+	}
+
+	// The Job only runs once the Deployment is fully available
+	reconcileTimes(4)
+	assert.Empty(t, listTestHookJobs(t, c, render.PostDeployHook))
+	deployment := getDeployment()
+	deployment.Status = appsv1.DeploymentStatus{Replicas: 1, UpdatedReplicas: 1, AvailableReplicas: 1}
+	assert.NoError(t, c.Status().Update(context.TODO(), deployment))
+	result, err := r.Reconcile(request)
+	assert.NoError(t, err)
+	assert.Equal(t, readyPollInterval, result.RequeueAfter)
+	completeTestJob(t, c, getJob("myimage"), batchv1.JobComplete)
+	reconcileTimes(1)
+	good := getApp().Status.LastKnownGood
+	if assert.NotNil(t, good) {
+		assert.Equal(t, render.HookRevision("myimage", ""), good.Revision)
+		assert.Contains(t, string(good.Template.Raw), `"image":"myimage"`)
+	}
+
+	// The failure of the Job of the next image restores the previous pod template
+	setImage("myimage:2")
+	reconcileTimes(1)
+	assert.Equal(t, "myimage:2", getDeployment().Spec.Template.Spec.Containers[0].Image)
+	failed := getJob("myimage:2")
+	completeTestJob(t, c, failed, batchv1.JobFailed)
+	reconcileTimes(1)
+	assert.Equal(t, "myimage", getDeployment().Spec.Template.Spec.Containers[0].Image)
+	found := getApp()
+	assert.Equal(t, "RolledBack", found.Status.State)
+	condition := findCondition(&found.Status, vz.ConditionRolledBack)
+	if assert.NotNil(t, condition) {
+		assert.Equal(t, corev1.ConditionTrue, condition.Status)
+		assert.Equal(t, "Post-deploy hook Job myns/"+failed.Name+" failed, restored revision "+good.Revision, condition.Message)
+	}
+
+	reconcileTimes(2)
+	assert.Equal(t, "myimage", getDeployment().Spec.Template.Spec.Containers[0].Image, "Expected the failed revision not to roll out again")
+
+	// A new image rolls out and clears the condition once its Job succeeded
+	setImage("myimage:3")
+	reconcileTimes(1)
+	assert.Equal(t, "myimage:3", getDeployment().Spec.Template.Spec.Containers[0].Image)
+	completeTestJob(t, c, getJob("myimage:3"), batchv1.JobComplete)
+	reconcileTimes(1)
+	found = getApp()
+	assert.Nil(t, findCondition(&found.Status, vz.ConditionRolledBack))
+	assert.Equal(t, render.HookRevision("myimage:3", ""), found.Status.LastKnownGood.Revision)
+}
+
+// Test the failure of the post-deploy Job leaves the pods as they are without a rollback
+func TestReconcilePostDeployHookNoRollback(t *testing.T) {
+	s := newTestScheme(t)
+	app := newTestApp("orders", "myns", "myns")
+	app.Spec.Hooks = &vz.HooksSpec{PostDeploy: &vz.HookSpec{Command: []string{"smoke-test"}}, RollbackPolicy: vz.RollbackNone}
+	c := fake.NewFakeClientWithScheme(s, app)
+	r := &ReconcileHelidonApp{client: c, scheme: s}
+	request := reconcile.Request{NamespacedName: types.NamespacedName{Namespace: "myns", Name: "orders"}}
+	for i := 0; i < 4; i++ {
+		_, err := r.Reconcile(request)
+		assert.NoError(t, err)
+	}
+	deployment := &appsv1.Deployment{}
+	assert.NoError(t, c.Get(context.TODO(), request.NamespacedName, deployment))
+	deployment.Status = appsv1.DeploymentStatus{Replicas: 1, UpdatedReplicas: 1, AvailableReplicas: 1}
+	assert.NoError(t, c.Status().Update(context.TODO(), deployment))
+	_, err := r.Reconcile(request)
+	assert.NoError(t, err)
+	jobs := listTestHookJobs(t, c, render.PostDeployHook)
+	if !assert.Len(t, jobs, 1) {
+		return
+	}
+	completeTestJob(t, c, &jobs[0], batchv1.JobFailed)
+	_, err = r.Reconcile(request)
+	assert.NoError(t, err)
+
+	found := &vz.HelidonApp{}
+	assert.NoError(t, c.Get(context.TODO(), request.NamespacedName, found))
+	assert.Equal(t, "Failed", found.Status.State)
+	assert.Equal(t, "Helidon application post-deploy hook failed. See the logs of Job myns/"+jobs[0].Name, found.Status.LastActionMessage)
+	assert.Nil(t, found.Status.LastKnownGood)
+	assert.Nil(t, findCondition(&found.Status, vz.ConditionRolledBack))
+}
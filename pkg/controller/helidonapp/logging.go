@@ -0,0 +1,91 @@
+// Copyright (c) 2020, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package helidonapp
+
+import (
+	"context"
+	"reflect"
+
+	"go.uber.org/zap"
+
+	verrazzanov1 "github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1"
+	"github.com/verrazzano/verrazzano-helidon-app-operator/pkg/render"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// reconcileLoggingConfigMap creates or updates the ConfigMap holding the logging configuration generated from
+// spec.logging, and deletes it when spec.logging is removed. Returns true when the reconcile must stop, after the
+// ConfigMap is created or when it can't be managed.
+func (r *ReconcileHelidonApp) reconcileLoggingConfigMap(reqLogger *zap.SugaredLogger, cr *verrazzanov1.HelidonApp) (bool, reconcile.Result, error) {
+	name := render.LoggingConfigMapName(cr.Spec.Name)
+	found := &corev1.ConfigMap{}
+	err := r.client.Get(context.TODO(), types.NamespacedName{Name: name, Namespace: cr.Spec.Namespace}, found)
+	if err != nil && !errors.IsNotFound(err) {
+		return true, reconcile.Result{}, err
+	}
+	exists := err == nil
+
+	configMap := render.LoggingConfigMap(cr)
+	if configMap == nil {
+		if exists && hasOwnerLabels(cr, found) {
+			reqLogger.Infof("Deleting logging ConfigMap, Name: %s Namespace: %s", name, cr.Spec.Namespace)
+			if err := r.client.Delete(context.TODO(), found); err != nil && !errors.IsNotFound(err) {
+				return true, reconcile.Result{}, err
+			}
+		}
+		return false, reconcile.Result{}, nil
+	}
+
+	if !exists {
+		if err := setOwnership(cr, configMap, r.scheme); err != nil {
+			return true, reconcile.Result{}, err
+		}
+		reqLogger.Infof("Creating logging ConfigMap, Name: %s Namespace: %s", name, cr.Spec.Namespace)
+		if err := r.client.Create(context.TODO(), configMap); err != nil {
+			reqLogger.Errorf("Failed to create ConfigMap, Name: %s Namespace: %s, Error: %s", name, cr.Spec.Namespace, err.Error())
+			r.updateStatus(reqLogger, cr, "Failed", "Helidon application logging ConfigMap creation failed: "+err.Error())
+			return true, reconcile.Result{}, err
+		}
+
+		// ConfigMap created successfully - return and requeue, the Deployment mounts it
+		return true, reconcile.Result{Requeue: true}, nil
+	}
+	if !r.checkAdoption(reqLogger, cr, found, "ConfigMap") {
+		return true, reconcile.Result{}, nil
+	}
+
+	updateNeeded, err := r.updateLoggingConfigMap(cr, found)
+	if err != nil || !updateNeeded {
+		return err != nil, reconcile.Result{}, err
+	}
+	reqLogger.Infof("Updating logging ConfigMap, Name: %s Namespace: %s", name, cr.Spec.Namespace)
+	if err := r.client.Update(context.TODO(), found); err != nil {
+		reqLogger.Errorf("Failed to update ConfigMap, Name: %s Namespace: %s, Error: %s", name, cr.Spec.Namespace, err.Error())
+		r.updateStatus(reqLogger, cr, cr.Status.State, "Helidon application logging ConfigMap update failed: "+err.Error())
+		return true, reconcile.Result{}, err
+	}
+	return false, reconcile.Result{}, nil
+}
+
+// updateLoggingConfigMap changes the existing logging ConfigMap to match spec.logging, returns true if it changed.
+// The pods pick up the change through the config hash, or in place with spec.logging.inPlace.
+func (r *ReconcileHelidonApp) updateLoggingConfigMap(cr *verrazzanov1.HelidonApp, found *corev1.ConfigMap) (bool, error) {
+	updateNeeded := false
+	desired := render.LoggingConfigMap(cr)
+	if !reflect.DeepEqual(found.Data, desired.Data) || len(found.BinaryData) > 0 {
+		found.Data = desired.Data
+		found.BinaryData = nil
+		updateNeeded = true
+	}
+	if !hasOwnerLabels(cr, found) {
+		if err := setOwnership(cr, found, r.scheme); err != nil {
+			return false, err
+		}
+		updateNeeded = true
+	}
+	return updateNeeded, nil
+}
@@ -0,0 +1,112 @@
+// Copyright (c) 2020, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package helidonapp
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	vz "github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// Test that the logging ConfigMap is created before the Deployment, that changing spec.logging updates it and
+// rolls the pods, and that removing spec.logging deletes it
+func TestReconcileLogging(t *testing.T) {
+	s := newTestScheme(t)
+	app := newTestApp("myapp", "myns", "myns")
+	app.Spec.Logging = &vz.LoggingSpec{Level: vz.LogLevelDebug}
+	c := fake.NewFakeClientWithScheme(s, app)
+	r := &ReconcileHelidonApp{client: c, scheme: s}
+	request := reconcile.Request{NamespacedName: types.NamespacedName{Namespace: "myns", Name: "myapp"}}
+	key := types.NamespacedName{Namespace: "myns", Name: "myapp-logging"}
+
+	// Reconciles create the namespace, the ConfigMap, the Deployment and the Service one at a time
+	for i := 0; i < 2; i++ {
+		result, err := r.Reconcile(request)
+		assert.NoError(t, err)
+		assert.True(t, result.Requeue)
+	}
+	configMap := &corev1.ConfigMap{}
+	assert.NoError(t, c.Get(context.TODO(), key, configMap))
+	assert.Contains(t, configMap.Data["logging.properties"], ".level = FINE\n")
+	assert.Equal(t, "myapp", configMap.Labels[OwnerNameLabel])
+	assert.Len(t, configMap.OwnerReferences, 1)
+	for i := 0; i < 2; i++ {
+		_, err := r.Reconcile(request)
+		assert.NoError(t, err)
+	}
+	deployment := &appsv1.Deployment{}
+	assert.NoError(t, c.Get(context.TODO(), request.NamespacedName, deployment))
+	hash := deployment.Spec.Template.Annotations[ConfigHashAnnotation]
+	assert.NotEmpty(t, hash)
+
+	found := &vz.HelidonApp{}
+	assert.NoError(t, c.Get(context.TODO(), request.NamespacedName, found))
+	found.Spec.Logging.Levels = map[string]vz.LogLevel{"io.helidon.webserver": vz.LogLevelTrace}
+	assert.NoError(t, c.Update(context.TODO(), found))
+	_, err := r.Reconcile(request)
+	assert.NoError(t, err)
+	configMap = &corev1.ConfigMap{}
+	assert.NoError(t, c.Get(context.TODO(), key, configMap))
+	assert.Contains(t, configMap.Data["logging.properties"], "io.helidon.webserver.level = FINEST\n")
+	deployment = &appsv1.Deployment{}
+	assert.NoError(t, c.Get(context.TODO(), request.NamespacedName, deployment))
+	assert.NotEqual(t, hash, deployment.Spec.Template.Annotations[ConfigHashAnnotation])
+
+	found = &vz.HelidonApp{}
+	assert.NoError(t, c.Get(context.TODO(), request.NamespacedName, found))
+	found.Spec.Logging = nil
+	assert.NoError(t, c.Update(context.TODO(), found))
+	_, err = r.Reconcile(request)
+	assert.NoError(t, err)
+	assert.True(t, errors.IsNotFound(c.Get(context.TODO(), key, &corev1.ConfigMap{})))
+	deployment = &appsv1.Deployment{}
+	assert.NoError(t, c.Get(context.TODO(), request.NamespacedName, deployment))
+	assert.Empty(t, deployment.Spec.Template.Spec.Volumes)
+	assert.Empty(t, deployment.Spec.Template.Spec.Containers[0].Env)
+}
+
+// Test that a logging ConfigMap applied in place doesn't roll the pods
+// Test that invalid logging settings fail the HelidonApp without creating the Deployment, when the validating
+// webhook didn't reject them
+func TestReconcileInvalidLogging(t *testing.T) {
+	s := newTestScheme(t)
+	app := newTestApp("myapp", "myns", "myns")
+	app.Spec.Logging = &vz.LoggingSpec{Format: vz.LogFormatJSON}
+	c := fake.NewFakeClientWithScheme(s, app)
+	r := &ReconcileHelidonApp{client: c, scheme: s}
+	request := reconcile.Request{NamespacedName: types.NamespacedName{Namespace: "myns", Name: "myapp"}}
+
+	_, err := r.Reconcile(request)
+	assert.NoError(t, err)
+	found := &vz.HelidonApp{}
+	assert.NoError(t, c.Get(context.TODO(), request.NamespacedName, found))
+	assert.Equal(t, "Failed", found.Status.State)
+	assert.Contains(t, found.Status.LastActionMessage, "Helidon application has invalid Helidon settings")
+	err = c.Get(context.TODO(), types.NamespacedName{Namespace: "myns", Name: "myapp"}, &appsv1.Deployment{})
+	assert.True(t, errors.IsNotFound(err), "Expected no deployment")
+
+	_, err = r.Reconcile(request)
+	assert.NoError(t, err)
+	again := &vz.HelidonApp{}
+	assert.NoError(t, c.Get(context.TODO(), request.NamespacedName, again))
+	assert.Equal(t, found.ResourceVersion, again.ResourceVersion, "Expected the status not to be updated again")
+}
+
+func TestConfigReferencesLogging(t *testing.T) {
+	app := newTestApp("myapp", "myns", "myns")
+	app.Spec.Logging = &vz.LoggingSpec{}
+	assert.Equal(t, []configReference{{"ConfigMap", "myapp-logging"}}, configReferences(app))
+	app.Spec.Logging.InPlace = true
+	assert.Equal(t, []configReference{{"ConfigMap", "myapp-logging"}}, configReferences(app), "Expected java.util.logging not to reload its configuration")
+	app.Spec.Logging.Framework = vz.LoggingFrameworkLog4j2
+	assert.Empty(t, configReferences(app))
+}
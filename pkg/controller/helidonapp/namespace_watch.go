@@ -0,0 +1,54 @@
+// Copyright (c) 2020, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package helidonapp
+
+import (
+	"context"
+	"reflect"
+
+	"go.uber.org/zap"
+
+	verrazzanov1 "github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// namespaceRequestMapper returns a mapper that enqueues all HelidonApps in a namespace
+func namespaceRequestMapper(c client.Client) handler.ToRequestsFunc {
+	return func(a handler.MapObject) []reconcile.Request {
+		apps := &verrazzanov1.HelidonAppList{}
+		err := c.List(context.TODO(), apps, client.InNamespace(a.Meta.GetName()))
+		if err != nil {
+			zap.S().Errorf("Failed to list HelidonApps in namespace %s, Error: %s", a.Meta.GetName(), err.Error())
+			return nil
+		}
+		var requests []reconcile.Request
+		for _, app := range apps.Items {
+			requests = append(requests, reconcile.Request{
+				NamespacedName: types.NamespacedName{Namespace: app.Namespace, Name: app.Name},
+			})
+		}
+		return requests
+	}
+}
+
+// namespaceLabelsChanged only lets through namespace events that may change whether the namespace is selected
+var namespaceLabelsChanged = predicate.Funcs{
+	CreateFunc: func(e event.CreateEvent) bool {
+		return len(e.Meta.GetLabels()) > 0
+	},
+	UpdateFunc: func(e event.UpdateEvent) bool {
+		return !reflect.DeepEqual(e.MetaOld.GetLabels(), e.MetaNew.GetLabels())
+	},
+	DeleteFunc: func(e event.DeleteEvent) bool {
+		return false
+	},
+	GenericFunc: func(e event.GenericEvent) bool {
+		return false
+	},
+}
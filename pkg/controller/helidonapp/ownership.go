@@ -0,0 +1,173 @@
+// Copyright (c) 2020, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package helidonapp
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	verrazzanov1 "github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1"
+	"github.com/verrazzano/verrazzano-helidon-app-operator/pkg/render"
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+const (
+	// OwnerNamespaceLabel is the label holding the namespace of the HelidonApp that owns a resource
+	OwnerNamespaceLabel = render.OwnerNamespaceLabel
+	// OwnerNameLabel is the label holding the name of the HelidonApp that owns a resource
+	OwnerNameLabel = render.OwnerNameLabel
+	// Finalizer is the finalizer used to clean up resources that can't be garbage collected by Kubernetes
+	Finalizer = "helidonapp.verrazzano.io/finalizer"
+)
+
+// ownerLabels returns the labels identifying resources owned by the given HelidonApp
+func ownerLabels(cr *verrazzanov1.HelidonApp) map[string]string {
+	return render.OwnerLabels(cr)
+}
+
+// setOwnership labels obj as owned by the HelidonApp. An owner reference is only set when obj lives in the
+// same namespace as the CR, since Kubernetes garbage collection does not support cross-namespace owners.
+func setOwnership(cr *verrazzanov1.HelidonApp, obj metav1.Object, scheme *runtime.Scheme) error {
+	render.SetOwnerLabels(cr, obj)
+
+	if obj.GetNamespace() != cr.Namespace {
+		return nil
+	}
+	return controllerutil.SetControllerReference(cr, obj, scheme)
+}
+
+// hasOwnerLabels checks if obj is already labeled as owned by the HelidonApp
+func hasOwnerLabels(cr *verrazzanov1.HelidonApp, obj metav1.Object) bool {
+	labels := obj.GetLabels()
+	for k, v := range ownerLabels(cr) {
+		if labels[k] != v {
+			return false
+		}
+	}
+	return true
+}
+
+// containsFinalizer checks if the finalizer is present on the CR
+func containsFinalizer(cr *verrazzanov1.HelidonApp) bool {
+	for _, f := range cr.GetFinalizers() {
+		if f == Finalizer {
+			return true
+		}
+	}
+	return false
+}
+
+// ensureFinalizer adds the finalizer to the CR if needed
+func (r *ReconcileHelidonApp) ensureFinalizer(reqLogger *zap.SugaredLogger, cr *verrazzanov1.HelidonApp) error {
+	if containsFinalizer(cr) {
+		return nil
+	}
+	reqLogger.Infow("Adding finalizer")
+	controllerutil.AddFinalizer(cr, Finalizer)
+	return r.client.Update(context.TODO(), cr)
+}
+
+// finalize deletes the resources owned by the HelidonApp and then removes the finalizer so the CR can be deleted
+func (r *ReconcileHelidonApp) finalize(reqLogger *zap.SugaredLogger, cr *verrazzanov1.HelidonApp) error {
+	if !containsFinalizer(cr) {
+		return nil
+	}
+	if err := r.deleteOwnedResources(reqLogger, cr, cr.Spec.Namespace); err != nil {
+		return err
+	}
+	// Resources may still exist in the previous namespaces if spec.namespace was changed
+	previous, err := r.previousTargets(cr)
+	if err != nil {
+		return err
+	}
+	deleted := map[string]bool{cr.Spec.Namespace: true}
+	for _, target := range previous {
+		if deleted[target.Namespace] {
+			continue
+		}
+		deleted[target.Namespace] = true
+		if err := r.deleteOwnedResources(reqLogger, cr, target.Namespace); err != nil {
+			return err
+		}
+	}
+	reqLogger.Infow("Removing finalizer")
+	controllerutil.RemoveFinalizer(cr, Finalizer)
+	return r.client.Update(context.TODO(), cr)
+}
+
+// deleteOwnedResources deletes the deployments, services, serviceaccounts, configmaps and jobs in namespace labeled as owned by the CR
+func (r *ReconcileHelidonApp) deleteOwnedResources(reqLogger *zap.SugaredLogger, cr *verrazzanov1.HelidonApp, namespace string) error {
+	opts := []client.ListOption{client.InNamespace(namespace), client.MatchingLabels(ownerLabels(cr))}
+
+	var objs []runtime.Object
+	deployments := &appsv1.DeploymentList{}
+	if err := r.client.List(context.TODO(), deployments, opts...); err != nil {
+		return err
+	}
+	for i := range deployments.Items {
+		objs = append(objs, &deployments.Items[i])
+	}
+	services := &corev1.ServiceList{}
+	if err := r.client.List(context.TODO(), services, opts...); err != nil {
+		return err
+	}
+	for i := range services.Items {
+		objs = append(objs, &services.Items[i])
+	}
+	serviceAccounts := &corev1.ServiceAccountList{}
+	if err := r.client.List(context.TODO(), serviceAccounts, opts...); err != nil {
+		return err
+	}
+	for i := range serviceAccounts.Items {
+		objs = append(objs, &serviceAccounts.Items[i])
+	}
+	configMaps := &corev1.ConfigMapList{}
+	if err := r.client.List(context.TODO(), configMaps, opts...); err != nil {
+		return err
+	}
+	for i := range configMaps.Items {
+		objs = append(objs, &configMaps.Items[i])
+	}
+	jobs := &batchv1.JobList{}
+	if err := r.client.List(context.TODO(), jobs, opts...); err != nil {
+		return err
+	}
+	for i := range jobs.Items {
+		objs = append(objs, &jobs.Items[i])
+	}
+
+	for _, obj := range objs {
+		meta := obj.(metav1.Object)
+		reqLogger.Infof("Deleting %T, Name: %s Namespace: %s", obj, meta.GetName(), meta.GetNamespace())
+		err := r.client.Delete(context.TODO(), obj, client.PropagationPolicy(metav1.DeletePropagationBackground))
+		if err != nil && !errors.IsNotFound(err) {
+			reqLogger.Errorf("Failed to delete %T, Name: %s Namespace: %s, Error: %s", obj, meta.GetName(), meta.GetNamespace(), err.Error())
+			return err
+		}
+	}
+	return nil
+}
+
+// ownerRequestMapper maps a resource labeled with the owner labels back to the HelidonApp that owns it
+var ownerRequestMapper = handler.ToRequestsFunc(func(a handler.MapObject) []reconcile.Request {
+	name := render.OwnerName(a.Meta)
+	namespace, namespaceFound := a.Meta.GetLabels()[OwnerNamespaceLabel]
+	if name == "" || !namespaceFound {
+		return nil
+	}
+	return []reconcile.Request{
+		{NamespacedName: types.NamespacedName{Namespace: namespace, Name: name}},
+	}
+})
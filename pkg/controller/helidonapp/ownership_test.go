@@ -0,0 +1,165 @@
+// Copyright (c) 2020, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package helidonapp
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	vz "github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1"
+	"github.com/verrazzano/verrazzano-helidon-app-operator/pkg/render"
+	"go.uber.org/zap"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// Test that resources in the CR namespace get an owner reference and resources in other namespaces only get labels
+func TestSetOwnership(t *testing.T) {
+	s := newTestScheme(t)
+	app := newTestApp("myapp", "crns", "crns")
+
+	svc := render.Service(app)
+	assert.NoError(t, setOwnership(app, svc, s))
+	assert.True(t, hasOwnerLabels(app, svc), "Expected owner labels")
+	assert.Equal(t, 1, len(svc.OwnerReferences), "Expected owner reference in same namespace")
+	assert.Equal(t, "myapp", svc.Labels["app"], "Expected existing labels to be kept")
+
+	app = newTestApp("myapp", "crns", "targetns")
+	svc = render.Service(app)
+	assert.NoError(t, setOwnership(app, svc, s))
+	assert.True(t, hasOwnerLabels(app, svc), "Expected owner labels")
+	assert.Equal(t, "crns", svc.Labels[OwnerNamespaceLabel])
+	assert.Equal(t, "myapp", svc.Labels[OwnerNameLabel])
+	assert.Equal(t, 0, len(svc.OwnerReferences), "Expected no owner reference across namespaces")
+	assert.NotContains(t, svc.Annotations, render.OwnerNameAnnotation)
+}
+
+// Test that the name of a HelidonApp too long for a label value is shortened, and kept in the annotation
+func TestSetOwnershipLongName(t *testing.T) {
+	s := newTestScheme(t)
+	name := strings.Repeat("a", 70)
+	app := newTestApp(name, "crns", "targetns")
+	app.Spec.Name = "myapp"
+
+	svc := render.Service(app)
+	assert.NoError(t, setOwnership(app, svc, s))
+	assert.Len(t, svc.Labels[OwnerNameLabel], 63)
+	assert.True(t, strings.HasPrefix(svc.Labels[OwnerNameLabel], strings.Repeat("a", 52)+"-"))
+	assert.Equal(t, name, svc.Annotations[render.OwnerNameAnnotation])
+	assert.True(t, hasOwnerLabels(app, svc), "Expected owner labels")
+
+	other := newTestApp(strings.Repeat("a", 69)+"b", "crns", "targetns")
+	assert.False(t, hasOwnerLabels(other, svc), "Expected names with the same start to have different labels")
+
+	requests := ownerRequestMapper.Map(handler.MapObject{Meta: svc, Object: svc})
+	assert.Equal(t, []reconcile.Request{{NamespacedName: types.NamespacedName{Namespace: "crns", Name: name}}}, requests)
+}
+
+// Test mapping a labeled resource back to the owning HelidonApp
+func TestOwnerRequestMapper(t *testing.T) {
+	svc := &corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: "svc", Namespace: "targetns"}}
+	requests := ownerRequestMapper.Map(handler.MapObject{Meta: svc, Object: svc})
+	assert.Equal(t, 0, len(requests), "Expected no requests for unlabeled resource")
+
+	svc.Labels = map[string]string{OwnerNamespaceLabel: "crns", OwnerNameLabel: "myapp"}
+	requests = ownerRequestMapper.Map(handler.MapObject{Meta: svc, Object: svc})
+	assert.Equal(t, []reconcile.Request{{NamespacedName: types.NamespacedName{Namespace: "crns", Name: "myapp"}}}, requests)
+}
+
+// Test that deleting a cross-namespace HelidonApp deletes its resources and removes the finalizer
+func TestFinalizeCrossNamespace(t *testing.T) {
+	s := newTestScheme(t)
+	app := newTestApp("myapp", "crns", "targetns")
+	app.Finalizers = []string{Finalizer}
+	now := metav1.Now()
+	app.DeletionTimestamp = &now
+
+	deploy := render.Deployment(app)
+	assert.NoError(t, setOwnership(app, deploy, s))
+	svc := render.Service(app)
+	assert.NoError(t, setOwnership(app, svc, s))
+	other := &corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: "targetns"}}
+
+	c := fake.NewFakeClientWithScheme(s, app, deploy, svc, other)
+	r := &ReconcileHelidonApp{client: c, scheme: s}
+	_, err := r.Reconcile(reconcile.Request{NamespacedName: types.NamespacedName{Namespace: "crns", Name: "myapp"}})
+	assert.NoError(t, err)
+
+	err = c.Get(context.TODO(), types.NamespacedName{Namespace: "targetns", Name: "myapp"}, &appsv1.Deployment{})
+	assert.True(t, errors.IsNotFound(err), "Expected deployment to be deleted")
+	err = c.Get(context.TODO(), types.NamespacedName{Namespace: "targetns", Name: "myapp"}, &corev1.Service{})
+	assert.True(t, errors.IsNotFound(err), "Expected service to be deleted")
+	assert.NoError(t, c.Get(context.TODO(), types.NamespacedName{Namespace: "targetns", Name: "other"}, &corev1.Service{}),
+		"Expected unrelated service to be kept")
+
+	found := &vz.HelidonApp{}
+	assert.NoError(t, c.Get(context.TODO(), types.NamespacedName{Namespace: "crns", Name: "myapp"}, found))
+	assert.False(t, containsFinalizer(found), "Expected finalizer to be removed")
+}
+
+// Test that the finalizer deletes the resources of a previous namespace that was never recorded in the status
+func TestFinalizeUnrecordedNamespace(t *testing.T) {
+	s := newTestScheme(t)
+	app := newTestApp("myapp", "crns", "targetns")
+	app.Finalizers = []string{Finalizer}
+	now := metav1.Now()
+	app.DeletionTimestamp = &now
+	app.Status.AppliedName = "myapp"
+	app.Status.AppliedNamespace = "targetns"
+
+	previous := newTestApp("myapp", "crns", "midns")
+	deploy := render.Deployment(previous)
+	assert.NoError(t, setOwnership(previous, deploy, s))
+
+	c := fake.NewFakeClientWithScheme(s, app, deploy)
+	r := &ReconcileHelidonApp{client: c, scheme: s}
+	_, err := r.Reconcile(reconcile.Request{NamespacedName: types.NamespacedName{Namespace: "crns", Name: "myapp"}})
+	assert.NoError(t, err)
+
+	err = c.Get(context.TODO(), types.NamespacedName{Namespace: "midns", Name: "myapp"}, &appsv1.Deployment{})
+	assert.True(t, errors.IsNotFound(err), "Expected deployment of the previous namespace to be deleted")
+}
+
+// Test that reconciling a new HelidonApp adds the finalizer
+func TestEnsureFinalizer(t *testing.T) {
+	s := newTestScheme(t)
+	app := newTestApp("myapp", "crns", "targetns")
+	c := fake.NewFakeClientWithScheme(s, app)
+	r := &ReconcileHelidonApp{client: c, scheme: s}
+	assert.NoError(t, r.ensureFinalizer(zap.S(), app))
+
+	found := &vz.HelidonApp{}
+	assert.NoError(t, c.Get(context.TODO(), types.NamespacedName{Namespace: "crns", Name: "myapp"}, found))
+	assert.True(t, containsFinalizer(found), "Expected finalizer to be added")
+}
+
+func newTestScheme(t *testing.T) *runtime.Scheme {
+	s := runtime.NewScheme()
+	assert.NoError(t, scheme.AddToScheme(s))
+	assert.NoError(t, vz.AddToScheme(s))
+	return s
+}
+
+func newTestApp(name string, namespace string, targetNamespace string) *vz.HelidonApp {
+	app := &vz.HelidonApp{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+	}
+	app.Spec.Name = name
+	app.Spec.Namespace = targetNamespace
+	app.Spec.Container.Image = "myimage"
+	return app
+}
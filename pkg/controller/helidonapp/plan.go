@@ -0,0 +1,346 @@
+// Copyright (c) 2020, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package helidonapp
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"go.uber.org/zap"
+
+	verrazzanov1 "github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1"
+	"github.com/verrazzano/verrazzano-helidon-app-operator/pkg/render"
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// PlanAnnotation puts a HelidonApp in plan mode when "true", or out of plan mode when "false", overriding
+// the operator wide setting
+const PlanAnnotation = "helidonapp.verrazzano.io/plan"
+
+// isPlanMode checks if the changes to the resources of the HelidonApp are planned instead of applied
+func isPlanMode(cr *verrazzanov1.HelidonApp, operatorPlanMode bool) bool {
+	if value, ok := cr.Annotations[PlanAnnotation]; ok {
+		if planMode, err := strconv.ParseBool(value); err == nil {
+			return planMode
+		}
+	}
+	return operatorPlanMode
+}
+
+// reconcilePlan records the changes the operator would apply in status.plannedChanges, without applying them.
+// An Event is emitted when the planned changes change.
+func (r *ReconcileHelidonApp) reconcilePlan(reqLogger *zap.SugaredLogger, cr *verrazzanov1.HelidonApp) (reconcile.Result, error) {
+	changes, err := r.computePlan(cr)
+	if err != nil {
+		reqLogger.Errorf("Failed to compute planned changes, Name: %s Namespace: %s, Error: %s", cr.Name, cr.Namespace, err.Error())
+		return reconcile.Result{}, err
+	}
+	if reflect.DeepEqual(changes, cr.Status.PlannedChanges) && cr.Status.State == "Planned" {
+		return reconcile.Result{}, nil
+	}
+
+	summary := summarizePlan(changes)
+	reqLogger.Infof("Planned changes, Name: %s Namespace: %s, %s", cr.Name, cr.Namespace, summary)
+	if r.recorder != nil {
+		r.recorder.Event(cr, corev1.EventTypeNormal, "Planned", summary)
+	}
+	cr.Status.PlannedChanges = changes
+	return reconcile.Result{}, r.updateStatus(reqLogger, cr, "Planned", summary)
+}
+
+// clearPlan removes the planned changes from the status once the HelidonApp leaves plan mode
+func (r *ReconcileHelidonApp) clearPlan(reqLogger *zap.SugaredLogger, cr *verrazzanov1.HelidonApp) error {
+	if len(cr.Status.PlannedChanges) == 0 {
+		return nil
+	}
+	cr.Status.PlannedChanges = nil
+	return r.updateStatus(reqLogger, cr, cr.Status.State, "Helidon application left plan mode")
+}
+
+// summarizePlan returns a one line description of the planned changes
+func summarizePlan(changes []verrazzanov1.PlannedChange) string {
+	if len(changes) == 0 {
+		return "No changes planned"
+	}
+	var descriptions []string
+	for _, change := range changes {
+		description := fmt.Sprintf("%s %s %s", change.Action, change.Kind, change.Name)
+		if change.Namespace != "" {
+			description = fmt.Sprintf("%s %s %s/%s", change.Action, change.Kind, change.Namespace, change.Name)
+		}
+		if len(change.Fields) > 0 {
+			description += " (" + strings.Join(change.Fields, ", ") + ")"
+		}
+		if change.HeldBy != "" {
+			description += ", held: " + change.HeldBy
+		}
+		descriptions = append(descriptions, description)
+	}
+	return fmt.Sprintf("%d changes planned: %s", len(changes), strings.Join(descriptions, "; "))
+}
+
+// computePlan returns the changes a reconcile would apply to the resources of the HelidonApp
+func (r *ReconcileHelidonApp) computePlan(cr *verrazzanov1.HelidonApp) ([]verrazzanov1.PlannedChange, error) {
+	var changes []verrazzanov1.PlannedChange
+	planHeld := func(kind string, meta metav1.Object, action verrazzanov1.PlannedAction, fields []string, heldBy string) {
+		changes = append(changes, verrazzanov1.PlannedChange{
+			Kind:      kind,
+			Name:      meta.GetName(),
+			Namespace: meta.GetNamespace(),
+			Action:    action,
+			Fields:    fields,
+			HeldBy:    heldBy,
+		})
+	}
+	plan := func(kind string, meta metav1.Object, action verrazzanov1.PlannedAction, fields []string) {
+		planHeld(kind, meta, action, fields, "")
+	}
+
+	namespace := render.Namespace(cr)
+	if found, err := r.exists(namespace.Name, "", &corev1.Namespace{}); err != nil {
+		return nil, err
+	} else if !found {
+		plan("Namespace", namespace, verrazzanov1.PlannedCreate, nil)
+	}
+
+	if cr.Spec.ServiceAccountName != "" {
+		serviceAccount := render.ServiceAccount(cr)
+		if found, err := r.exists(serviceAccount.Name, serviceAccount.Namespace, &corev1.ServiceAccount{}); err != nil {
+			return nil, err
+		} else if !found {
+			plan("ServiceAccount", serviceAccount, verrazzanov1.PlannedCreate, nil)
+		}
+	}
+
+	loggingName := render.LoggingConfigMapName(cr.Spec.Name)
+	loggingFound := &corev1.ConfigMap{}
+	if found, err := r.exists(loggingName, cr.Spec.Namespace, loggingFound); err != nil {
+		return nil, err
+	} else if configMap := render.LoggingConfigMap(cr); configMap == nil {
+		if found && hasOwnerLabels(cr, loggingFound) {
+			plan("ConfigMap", loggingFound, verrazzanov1.PlannedDelete, nil)
+		}
+	} else if !found {
+		plan("ConfigMap", configMap, verrazzanov1.PlannedCreate, nil)
+	} else {
+		action, fields, err := r.planUpdate(cr, loggingFound, func(obj runtime.Object) (bool, error) {
+			return r.updateLoggingConfigMap(cr, obj.(*corev1.ConfigMap))
+		})
+		if err != nil {
+			return nil, err
+		}
+		if action != "" {
+			plan("ConfigMap", loggingFound, action, fields)
+		}
+	}
+
+	// A new pod template waits for the dependencies, and a new revision for the pre-deploy hook
+	waiting, err := r.resolveDependencies(cr, cr.Status.DeepCopy())
+	if err != nil {
+		return nil, err
+	}
+	deployment := render.Deployment(cr)
+	deployFound := &appsv1.Deployment{}
+	if found, err := r.exists(deployment.Name, deployment.Namespace, deployFound); err != nil {
+		return nil, err
+	} else if !found {
+		hash, err := r.configHash(cr)
+		if err != nil {
+			return nil, err
+		}
+		updateConfigHash(&deployment.Spec.Template.ObjectMeta, hash)
+		heldBy, hookJob, err := r.planRollout(cr, waiting, podRevision(&deployment.Spec.Template))
+		if err != nil {
+			return nil, err
+		}
+		if hookJob != nil {
+			plan("Job", hookJob, verrazzanov1.PlannedCreate, nil)
+		}
+		planHeld("Deployment", deployment, verrazzanov1.PlannedCreate, nil, heldBy)
+	} else {
+		action, fields, err := r.planUpdate(cr, deployFound, func(obj runtime.Object) (bool, error) {
+			return r.updateDeployment(cr, obj.(*appsv1.Deployment))
+		})
+		if err != nil {
+			return nil, err
+		}
+		heldBy := ""
+		if action == verrazzanov1.PlannedUpdate || action == verrazzanov1.PlannedAdopt {
+			updated := deployFound.DeepCopy()
+			if _, err := r.updateDeployment(cr, updated); err != nil {
+				return nil, err
+			}
+			if revision := podRevision(&updated.Spec.Template); revision != podRevision(&deployFound.Spec.Template) {
+				var hookJob *batchv1.Job
+				heldBy, hookJob, err = r.planRollout(cr, waiting, revision)
+				if err != nil {
+					return nil, err
+				}
+				if hookJob != nil {
+					plan("Job", hookJob, verrazzanov1.PlannedCreate, nil)
+				}
+			} else if !equality.Semantic.DeepEqual(updated.Spec.Template, deployFound.Spec.Template) {
+				heldBy = waiting
+			}
+		}
+		if action != "" {
+			planHeld("Deployment", deployFound, action, fields, heldBy)
+		}
+	}
+
+	service := render.Service(cr)
+	serviceFound := &corev1.Service{}
+	if found, err := r.exists(service.Name, service.Namespace, serviceFound); err != nil {
+		return nil, err
+	} else if !found {
+		plan("Service", service, verrazzanov1.PlannedCreate, nil)
+	} else {
+		action, fields, err := r.planUpdate(cr, serviceFound, func(obj runtime.Object) (bool, error) {
+			return r.updateService(cr, obj.(*corev1.Service))
+		})
+		if err != nil {
+			return nil, err
+		}
+		if action != "" {
+			plan("Service", serviceFound, action, fields)
+		}
+	}
+
+	// The previous resources are deleted once the new Deployment is ready
+	if isTargetChanged(cr) {
+		previous := []struct {
+			kind string
+			obj  runtime.Object
+		}{{"Deployment", &appsv1.Deployment{}}, {"Service", &corev1.Service{}}}
+		for _, p := range previous {
+			found, err := r.exists(cr.Status.AppliedName, cr.Status.AppliedNamespace, p.obj)
+			if err != nil {
+				return nil, err
+			}
+			if found && hasOwnerLabels(cr, p.obj.(metav1.Object)) {
+				plan(p.kind, p.obj.(metav1.Object), verrazzanov1.PlannedDelete, nil)
+			}
+		}
+	}
+	return changes, nil
+}
+
+// planRollout returns why the rollout of a new revision would be held back, empty if it wouldn't. While the
+// dependencies are Ready, it also returns the Job of spec.hooks.preDeploy when it would be created.
+func (r *ReconcileHelidonApp) planRollout(cr *verrazzanov1.HelidonApp, waiting string, revision string) (string, *batchv1.Job, error) {
+	if waiting != "" {
+		return waiting, nil, nil
+	}
+	if cr.Spec.Hooks == nil || cr.Spec.Hooks.PreDeploy == nil {
+		return "", nil, nil
+	}
+	job := render.HookJob(cr, render.PreDeployHook, cr.Spec.Hooks.PreDeploy, revision)
+	found := &batchv1.Job{}
+	exists, err := r.exists(job.Name, job.Namespace, found)
+	if err != nil {
+		return "", nil, err
+	}
+	running := fmt.Sprintf("Waiting for the pre-deploy hook Job %s/%s to succeed", job.Namespace, job.Name)
+	if !exists {
+		return running, job, nil
+	}
+	switch hookPhase(found) {
+	case verrazzanov1.HookSucceeded:
+		return "", nil, nil
+	case verrazzanov1.HookFailed:
+		return fmt.Sprintf("The pre-deploy hook Job %s/%s failed", job.Namespace, job.Name), nil, nil
+	}
+	return running, nil, nil
+}
+
+// planUpdate returns the action and the changed fields of an existing resource. The update is applied to a
+// copy of the resource, an empty action is returned when nothing would change.
+func (r *ReconcileHelidonApp) planUpdate(cr *verrazzanov1.HelidonApp, found runtime.Object, update func(runtime.Object) (bool, error)) (verrazzanov1.PlannedAction, []string, error) {
+	if !isOwned(cr, found.(metav1.Object)) && !isAdoptEnabled(cr) {
+		return verrazzanov1.PlannedConflict, nil, nil
+	}
+	updated := found.DeepCopyObject()
+	changed, err := update(updated)
+	if err != nil {
+		return "", nil, err
+	}
+	var fields []string
+	if changed {
+		fields, err = changedFields(found, updated)
+		if err != nil {
+			return "", nil, err
+		}
+	}
+	switch {
+	case !isOwned(cr, found.(metav1.Object)):
+		return verrazzanov1.PlannedAdopt, fields, nil
+	case len(fields) > 0:
+		return verrazzanov1.PlannedUpdate, fields, nil
+	}
+	return "", nil, nil
+}
+
+// exists gets the resource with the given name and namespace into obj, returns false if it doesn't exist
+func (r *ReconcileHelidonApp) exists(name string, namespace string, obj runtime.Object) (bool, error) {
+	err := r.client.Get(context.TODO(), types.NamespacedName{Name: name, Namespace: namespace}, obj)
+	if errors.IsNotFound(err) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+// changedFields returns the sorted paths of the fields that differ between two resources
+func changedFields(from runtime.Object, to runtime.Object) ([]string, error) {
+	fromMap, err := runtime.DefaultUnstructuredConverter.ToUnstructured(from)
+	if err != nil {
+		return nil, err
+	}
+	toMap, err := runtime.DefaultUnstructuredConverter.ToUnstructured(to)
+	if err != nil {
+		return nil, err
+	}
+	var fields []string
+	diffFields("", fromMap, toMap, &fields)
+	sort.Strings(fields)
+	return fields, nil
+}
+
+// diffFields appends the paths of the fields that differ between two unstructured values. Lists are
+// compared as a whole.
+func diffFields(path string, from interface{}, to interface{}, fields *[]string) {
+	fromMap, fromIsMap := from.(map[string]interface{})
+	toMap, toIsMap := to.(map[string]interface{})
+	if !fromIsMap || !toIsMap {
+		if !reflect.DeepEqual(from, to) {
+			*fields = append(*fields, path)
+		}
+		return
+	}
+	keys := make(map[string]bool)
+	for k := range fromMap {
+		keys[k] = true
+	}
+	for k := range toMap {
+		keys[k] = true
+	}
+	for k := range keys {
+		childPath := k
+		if path != "" {
+			childPath = path + "." + k
+		}
+		diffFields(childPath, fromMap[k], toMap[k], fields)
+	}
+}
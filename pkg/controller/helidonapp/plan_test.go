@@ -0,0 +1,141 @@
+// Copyright (c) 2020, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package helidonapp
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	vz "github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1"
+	"github.com/verrazzano/verrazzano-helidon-app-operator/pkg/render"
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+func TestIsPlanMode(t *testing.T) {
+	app := newTestApp("myapp", "crns", "crns")
+	assert.False(t, isPlanMode(app, false))
+	assert.True(t, isPlanMode(app, true))
+	app.Annotations = map[string]string{PlanAnnotation: "true"}
+	assert.True(t, isPlanMode(app, false))
+	app.Annotations[PlanAnnotation] = "false"
+	assert.False(t, isPlanMode(app, true), "Expected annotation to override the operator setting")
+}
+
+// Test that plan mode records the resources to create without creating them
+func TestReconcilePlanCreate(t *testing.T) {
+	s := newTestScheme(t)
+	app := newTestApp("myapp", "crns", "targetns")
+	c := fake.NewFakeClientWithScheme(s, app, newTestGrant("targetns", "crns"))
+	recorder := record.NewFakeRecorder(10)
+	r := &ReconcileHelidonApp{client: c, scheme: s, recorder: recorder, planMode: true}
+	request := reconcile.Request{NamespacedName: types.NamespacedName{Namespace: "crns", Name: "myapp"}}
+
+	_, err := r.Reconcile(request)
+	assert.NoError(t, err)
+
+	found := &vz.HelidonApp{}
+	assert.NoError(t, c.Get(context.TODO(), request.NamespacedName, found))
+	assert.Equal(t, "Planned", found.Status.State)
+	assert.Equal(t, []vz.PlannedChange{
+		{Kind: "Namespace", Name: "targetns", Action: vz.PlannedCreate},
+		{Kind: "Deployment", Name: "myapp", Namespace: "targetns", Action: vz.PlannedCreate},
+		{Kind: "Service", Name: "myapp", Namespace: "targetns", Action: vz.PlannedCreate},
+	}, found.Status.PlannedChanges)
+	assert.Equal(t, 1, len(recorder.Events), "Expected an event")
+	assert.False(t, containsFinalizer(found), "Expected no finalizer in plan mode")
+	err = c.Get(context.TODO(), types.NamespacedName{Namespace: "targetns", Name: "myapp"}, &appsv1.Deployment{})
+	assert.True(t, errors.IsNotFound(err), "Expected deployment not to be created")
+
+	// The same plan doesn't emit another event
+	_, err = r.Reconcile(request)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(recorder.Events), "Expected no new event")
+
+	// Leaving plan mode clears the plan
+	r.planMode = false
+	_, err = r.Reconcile(request)
+	assert.NoError(t, err)
+	found = &vz.HelidonApp{}
+	assert.NoError(t, c.Get(context.TODO(), request.NamespacedName, found))
+	assert.Empty(t, found.Status.PlannedChanges)
+}
+
+// Test that plan mode lists the fields of existing resources that would change
+func TestComputePlanUpdate(t *testing.T) {
+	s := newTestScheme(t)
+	app := newTestApp("myapp", "crns", "crns")
+	deploy := render.Deployment(app)
+	assert.NoError(t, setOwnership(app, deploy, s))
+	svc := render.Service(app)
+	ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "crns"}}
+	c := fake.NewFakeClientWithScheme(s, app, deploy, svc, ns)
+	r := &ReconcileHelidonApp{client: c, scheme: s}
+
+	changes, err := r.computePlan(app)
+	assert.NoError(t, err)
+	assert.Equal(t, []vz.PlannedChange{{Kind: "Service", Name: "myapp", Namespace: "crns", Action: vz.PlannedConflict}}, changes)
+
+	app.Spec.Container.Image = "newimage"
+	app.Annotations = map[string]string{"helidonapp.verrazzano.io/adopt": "true"}
+	changes, err = r.computePlan(app)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(changes))
+	assert.Equal(t, vz.PlannedChange{Kind: "Deployment", Name: "myapp", Namespace: "crns", Action: vz.PlannedUpdate,
+		Fields: []string{"spec.template.spec.containers"}}, changes[0])
+	assert.Equal(t, vz.PlannedAdopt, changes[1].Action)
+	assert.Equal(t, []string{"metadata.labels." + OwnerNameLabel, "metadata.labels." + OwnerNamespaceLabel, "metadata.ownerReferences"},
+		changes[1].Fields, "Expected ownership to be added to the service")
+
+	// Nothing was applied
+	deployFound := &appsv1.Deployment{}
+	assert.NoError(t, c.Get(context.TODO(), types.NamespacedName{Namespace: "crns", Name: "myapp"}, deployFound))
+	assert.Equal(t, "myimage", deployFound.Spec.Template.Spec.Containers[0].Image)
+}
+
+// Test that plan mode records the rollouts held back by the dependencies and by the pre-deploy hook
+func TestComputePlanHeld(t *testing.T) {
+	s := newTestScheme(t)
+	app := newTestApp("myapp", "crns", "crns")
+	app.Spec.DependsOn = []vz.Dependency{{Name: "db"}}
+	app.Spec.Hooks = &vz.HooksSpec{PreDeploy: &vz.HookSpec{Command: []string{"flyway", "migrate"}}}
+	ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "crns"}}
+	c := fake.NewFakeClientWithScheme(s, app, ns)
+	r := &ReconcileHelidonApp{client: c, scheme: s}
+
+	changes, err := r.computePlan(app)
+	assert.NoError(t, err)
+	assert.Equal(t, []vz.PlannedChange{
+		{Kind: "Deployment", Name: "myapp", Namespace: "crns", Action: vz.PlannedCreate, HeldBy: "Waiting for crns/db (not found) to be Ready"},
+		{Kind: "Service", Name: "myapp", Namespace: "crns", Action: vz.PlannedCreate},
+	}, changes)
+
+	// Once the dependencies are Ready, the pre-deploy Job runs first
+	app.Spec.DependsOn = nil
+	changes, err = r.computePlan(app)
+	assert.NoError(t, err)
+	if assert.Len(t, changes, 3) {
+		assert.Equal(t, "Job", changes[0].Kind)
+		assert.Equal(t, vz.PlannedCreate, changes[0].Action)
+		assert.Equal(t, "Deployment", changes[1].Kind)
+		assert.Equal(t, "Waiting for the pre-deploy hook Job crns/"+changes[0].Name+" to succeed", changes[1].HeldBy)
+	}
+
+	job := render.HookJob(app, render.PreDeployHook, app.Spec.Hooks.PreDeploy, podRevision(&render.Deployment(app).Spec.Template))
+	assert.NoError(t, c.Create(context.TODO(), job))
+	completeTestJob(t, c, job, batchv1.JobComplete)
+	changes, err = r.computePlan(app)
+	assert.NoError(t, err)
+	if assert.Len(t, changes, 2) {
+		assert.Equal(t, vz.PlannedChange{Kind: "Deployment", Name: "myapp", Namespace: "crns", Action: vz.PlannedCreate}, changes[0])
+	}
+}
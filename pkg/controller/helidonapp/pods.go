@@ -0,0 +1,180 @@
+// Copyright (c) 2020, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package helidonapp
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"go.uber.org/zap"
+
+	verrazzanov1 "github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+const (
+	// Index of HelidonApps by <spec.namespace>/<spec.name>, which is the namespace and app label of their pods
+	appIndex = "helidonapp.app"
+
+	// maxPodIssues limits the pod issues kept in the status
+	maxPodIssues = 10
+)
+
+// healthyWaitingReasons are the reasons containers wait for while starting normally
+var healthyWaitingReasons = map[string]bool{
+	"":                  true,
+	"ContainerCreating": true,
+	"PodInitializing":   true,
+}
+
+// listPods returns the pods selected by the deployment
+func (r *ReconcileHelidonApp) listPods(deployment *appsv1.Deployment) ([]corev1.Pod, error) {
+	selector, err := metav1.LabelSelectorAsSelector(deployment.Spec.Selector)
+	if err != nil {
+		return nil, err
+	}
+	pods := &corev1.PodList{}
+	err = r.client.List(context.TODO(), pods, client.InNamespace(deployment.Namespace), client.MatchingLabelsSelector{Selector: selector})
+	if err != nil {
+		return nil, err
+	}
+	return pods.Items, nil
+}
+
+// podIssues summarizes the problems of the pods and of their containers, sorted by pod and container
+func podIssues(pods []corev1.Pod) []verrazzanov1.PodIssue {
+	var issues []verrazzanov1.PodIssue
+	for i := range pods {
+		pod := &pods[i]
+		if pod.DeletionTimestamp != nil {
+			continue
+		}
+		if pod.Status.Phase == corev1.PodFailed {
+			issues = append(issues, verrazzanov1.PodIssue{Pod: pod.Name, Reason: defaultString(pod.Status.Reason, "Failed"), Message: pod.Status.Message})
+			continue
+		}
+		for _, c := range pod.Status.Conditions {
+			if c.Type == corev1.PodScheduled && c.Status == corev1.ConditionFalse && c.Reason == corev1.PodReasonUnschedulable {
+				issues = append(issues, verrazzanov1.PodIssue{Pod: pod.Name, Reason: c.Reason, Message: c.Message})
+			}
+		}
+		statuses := append(append([]corev1.ContainerStatus{}, pod.Status.InitContainerStatuses...), pod.Status.ContainerStatuses...)
+		for _, status := range statuses {
+			if issue := containerIssue(pod.Name, status); issue != nil {
+				issues = append(issues, *issue)
+			}
+		}
+	}
+	sort.SliceStable(issues, func(i, j int) bool {
+		if issues[i].Pod != issues[j].Pod {
+			return issues[i].Pod < issues[j].Pod
+		}
+		return issues[i].Container < issues[j].Container
+	})
+	if len(issues) > maxPodIssues {
+		issues = issues[:maxPodIssues]
+	}
+	return issues
+}
+
+// containerIssue returns the problem of a container waiting for an abnormal reason or terminated with an
+// error, or nil
+func containerIssue(pod string, status corev1.ContainerStatus) *verrazzanov1.PodIssue {
+	issue := &verrazzanov1.PodIssue{Pod: pod, Container: status.Name, RestartCount: status.RestartCount}
+	switch {
+	case status.State.Waiting != nil && !healthyWaitingReasons[status.State.Waiting.Reason]:
+		issue.Reason = status.State.Waiting.Reason
+		issue.Message = status.State.Waiting.Message
+	case status.State.Terminated != nil && status.State.Terminated.ExitCode != 0:
+		issue.Reason = defaultString(status.State.Terminated.Reason, "Error")
+		issue.Message = status.State.Terminated.Message
+	default:
+		return nil
+	}
+	if last := status.LastTerminationState.Terminated; last != nil {
+		issue.LastTerminationReason = last.Reason
+		issue.LastTerminationMessage = last.Message
+	}
+	return issue
+}
+
+// setPodStatus sets the pod issues and the Degraded condition
+func setPodStatus(status *verrazzanov1.HelidonAppStatus, issues []verrazzanov1.PodIssue) {
+	status.PodIssues = issues
+	if len(issues) == 0 {
+		setCondition(status, verrazzanov1.ConditionDegraded, corev1.ConditionFalse, "PodsHealthy", "The pods of the Helidon application have no problems")
+		return
+	}
+	setCondition(status, verrazzanov1.ConditionDegraded, corev1.ConditionTrue, issues[0].Reason, describePodIssues(issues))
+}
+
+// describePodIssues returns a human readable summary of the pod issues
+func describePodIssues(issues []verrazzanov1.PodIssue) string {
+	var descriptions []string
+	for _, issue := range issues {
+		var b strings.Builder
+		b.WriteString("pod " + issue.Pod)
+		if issue.Container != "" {
+			b.WriteString(" container " + issue.Container)
+		}
+		b.WriteString(": " + issue.Reason)
+		if issue.LastTerminationReason != "" {
+			b.WriteString(", last terminated with " + issue.LastTerminationReason)
+		}
+		if issue.RestartCount > 0 {
+			fmt.Fprintf(&b, ", %d restarts", issue.RestartCount)
+		}
+		descriptions = append(descriptions, b.String())
+	}
+	return strings.Join(descriptions, "; ")
+}
+
+// appIndexer indexes HelidonApps by <spec.namespace>/<spec.name>
+func appIndexer(obj runtime.Object) []string {
+	cr, ok := obj.(*verrazzanov1.HelidonApp)
+	if !ok {
+		return nil
+	}
+	return []string{cr.Spec.Namespace + "/" + cr.Spec.Name}
+}
+
+// podRequestMapper returns a mapper that enqueues the HelidonApp of a pod, found from its namespace and app
+// label with the index
+func podRequestMapper(c client.Client) handler.ToRequestsFunc {
+	return func(a handler.MapObject) []reconcile.Request {
+		name, ok := a.Meta.GetLabels()["app"]
+		if !ok {
+			return nil
+		}
+		apps := &verrazzanov1.HelidonAppList{}
+		err := c.List(context.TODO(), apps, client.MatchingFields{appIndex: a.Meta.GetNamespace() + "/" + name})
+		if err != nil {
+			zap.S().Errorf("Failed to list HelidonApps of pod %s/%s, Error: %s", a.Meta.GetNamespace(), a.Meta.GetName(), err.Error())
+			return nil
+		}
+		var requests []reconcile.Request
+		for _, app := range apps.Items {
+			requests = append(requests, reconcile.Request{
+				NamespacedName: types.NamespacedName{Namespace: app.Namespace, Name: app.Name},
+			})
+		}
+		return requests
+	}
+}
+
+func defaultString(value string, defaultValue string) string {
+	if value == "" {
+		return defaultValue
+	}
+	return value
+}
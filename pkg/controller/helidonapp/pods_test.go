@@ -0,0 +1,112 @@
+// Copyright (c) 2020, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package helidonapp
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	vz "github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// Test summarizing the problems of pods and containers
+func TestPodIssues(t *testing.T) {
+	pods := []corev1.Pod{
+		newTestPod("myapp-b", corev1.ContainerStatus{
+			Name:         "myapp",
+			RestartCount: 5,
+			State:        corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: "CrashLoopBackOff", Message: "back-off 5m0s"}},
+			LastTerminationState: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{
+				Reason: "OOMKilled", ExitCode: 137, Message: "out of memory"}},
+		}),
+		newTestPod("myapp-a", corev1.ContainerStatus{
+			Name:  "myapp",
+			State: corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: "ImagePullBackOff", Message: "image not found"}},
+		}),
+		newTestPod("myapp-c", corev1.ContainerStatus{
+			Name:  "myapp",
+			State: corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: "ContainerCreating"}},
+		}),
+		newTestPod("myapp-d", corev1.ContainerStatus{
+			Name:         "myapp",
+			RestartCount: 1,
+			State:        corev1.ContainerState{Running: &corev1.ContainerStateRunning{}},
+		}),
+	}
+	pending := newTestPod("myapp-e")
+	pending.Status.Conditions = []corev1.PodCondition{{
+		Type: corev1.PodScheduled, Status: corev1.ConditionFalse, Reason: corev1.PodReasonUnschedulable, Message: "0/3 nodes are available"}}
+	pods = append(pods, pending)
+
+	issues := podIssues(pods)
+	assert.Equal(t, []vz.PodIssue{
+		{Pod: "myapp-a", Container: "myapp", Reason: "ImagePullBackOff", Message: "image not found"},
+		{Pod: "myapp-b", Container: "myapp", Reason: "CrashLoopBackOff", Message: "back-off 5m0s", RestartCount: 5,
+			LastTerminationReason: "OOMKilled", LastTerminationMessage: "out of memory"},
+		{Pod: "myapp-e", Reason: "Unschedulable", Message: "0/3 nodes are available"},
+	}, issues)
+
+	status := &vz.HelidonAppStatus{}
+	setPodStatus(status, issues)
+	condition := findCondition(status, vz.ConditionDegraded)
+	if assert.NotNil(t, condition) {
+		assert.Equal(t, corev1.ConditionTrue, condition.Status)
+		assert.Equal(t, "ImagePullBackOff", condition.Reason)
+		assert.Contains(t, condition.Message, "pod myapp-b container myapp: CrashLoopBackOff, last terminated with OOMKilled, 5 restarts")
+	}
+	setPodStatus(status, nil)
+	assert.Equal(t, corev1.ConditionFalse, findCondition(status, vz.ConditionDegraded).Status)
+	assert.Nil(t, status.PodIssues)
+}
+
+// Test that reconciling reports the problems of the pods of the deployment
+func TestReconcilePodIssues(t *testing.T) {
+	s := newTestScheme(t)
+	app := newTestApp("myapp", "myns", "myns")
+	app.Spec.Container.Image = "myapp:1.0"
+	pod := newTestPod("myapp-a", corev1.ContainerStatus{
+		Name:  "myapp",
+		State: corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: "ErrImagePull"}},
+	})
+	other := newTestPod("other-a", corev1.ContainerStatus{
+		Name:  "other",
+		State: corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: "ErrImagePull"}},
+	})
+	other.Labels = map[string]string{"app": "other"}
+	// The selector of the Deployment includes the owner labels
+	for k, v := range ownerLabels(app) {
+		pod.Labels[k] = v
+	}
+	c := fake.NewFakeClientWithScheme(s, app, &pod, &other)
+	r := &ReconcileHelidonApp{client: c, scheme: s}
+	request := reconcile.Request{NamespacedName: types.NamespacedName{Namespace: "myns", Name: "myapp"}}
+
+	// Reconciles create the namespace, the Deployment and the Service one at a time
+	for i := 0; i < 4; i++ {
+		_, err := r.Reconcile(request)
+		assert.NoError(t, err)
+	}
+	found := &vz.HelidonApp{}
+	assert.NoError(t, c.Get(context.TODO(), request.NamespacedName, found))
+	assert.Equal(t, []vz.PodIssue{{Pod: "myapp-a", Container: "myapp", Reason: "ErrImagePull"}}, found.Status.PodIssues)
+	condition := findCondition(&found.Status, vz.ConditionDegraded)
+	if assert.NotNil(t, condition) {
+		assert.Equal(t, "ErrImagePull", condition.Reason)
+	}
+
+	assert.Equal(t, []string{"myns/myapp"}, appIndexer(app))
+}
+
+func newTestPod(name string, statuses ...corev1.ContainerStatus) corev1.Pod {
+	return corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "myns", Labels: map[string]string{"app": "myapp"}},
+		Status:     corev1.PodStatus{Phase: corev1.PodPending, ContainerStatuses: statuses},
+	}
+}
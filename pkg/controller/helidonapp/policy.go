@@ -0,0 +1,110 @@
+// Copyright (c) 2020, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package helidonapp
+
+import (
+	"context"
+	"strings"
+
+	"go.uber.org/zap"
+
+	verrazzanov1 "github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1"
+	"github.com/verrazzano/verrazzano-helidon-app-operator/pkg/imagepolicy"
+	"github.com/verrazzano/verrazzano-helidon-app-operator/pkg/tenancy"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// Reasons of the PolicyViolation condition
+const (
+	reasonTenancyViolation = "TenancyViolation"
+	reasonImagePolicy      = "ImagePolicyViolation"
+	reasonCompliant        = "Compliant"
+)
+
+// checkPolicies checks the HelidonApp against the tenancy policy and the image policies. Returns false and
+// updates the status when the HelidonApp violates a policy, the resources of the HelidonApp are then left alone.
+func (r *ReconcileHelidonApp) checkPolicies(reqLogger *zap.SugaredLogger, cr *verrazzanov1.HelidonApp) (bool, error) {
+	reason, violation, err := r.findViolation(cr)
+	if err != nil {
+		reqLogger.Errorf("Failed to check the policies, Name: %s Namespace: %s, Error: %s", cr.Name, cr.Namespace, err.Error())
+		return false, err
+	}
+
+	if violation == "" {
+		condition := findCondition(&cr.Status, verrazzanov1.ConditionPolicyViolation)
+		if condition == nil || condition.Status == corev1.ConditionFalse {
+			return true, nil
+		}
+		reqLogger.Infof("HelidonApp complies with the policies again, Name: %s Namespace: %s", cr.Name, cr.Namespace)
+		setCondition(&cr.Status, verrazzanov1.ConditionPolicyViolation, corev1.ConditionFalse, reasonCompliant, "")
+		return true, r.client.Status().Update(context.TODO(), cr)
+	}
+
+	var message string
+	if reason == reasonTenancyViolation {
+		message = "Helidon application violates the tenancy policy: " + violation
+	} else {
+		message = "Helidon application violates the image policy: " + violation
+	}
+	condition := findCondition(&cr.Status, verrazzanov1.ConditionPolicyViolation)
+	if cr.Status.State == "Failed" && cr.Status.LastActionMessage == message &&
+		condition != nil && condition.Status == corev1.ConditionTrue && condition.Message == violation {
+		return false, nil
+	}
+	reqLogger.Infof("HelidonApp violates a policy, Name: %s Namespace: %s, %s", cr.Name, cr.Namespace, violation)
+	if r.recorder != nil {
+		r.recorder.Event(cr, corev1.EventTypeWarning, reason, violation)
+	}
+	setCondition(&cr.Status, verrazzanov1.ConditionPolicyViolation, corev1.ConditionTrue, reason, violation)
+	return false, r.updateStatus(reqLogger, cr, "Failed", message)
+}
+
+// findViolation returns the reason and description of the first policy the HelidonApp violates, the
+// description is empty when the HelidonApp complies with all the policies
+func (r *ReconcileHelidonApp) findViolation(cr *verrazzanov1.HelidonApp) (string, string, error) {
+	reader := r.apiReader
+	if reader == nil {
+		reader = r.client
+	}
+	err := r.tenancy.Check(context.TODO(), reader, cr)
+	if tenancy.IsViolation(err) {
+		return reasonTenancyViolation, err.Error(), nil
+	} else if err != nil {
+		return "", "", err
+	}
+
+	violations, err := imagepolicy.Check(context.TODO(), reader, cr)
+	if err != nil {
+		return "", "", err
+	}
+	if len(violations) > 0 {
+		return reasonImagePolicy, strings.Join(violations, "; "), nil
+	}
+	return "", "", nil
+}
+
+// imagePolicyRequestMapper returns a mapper that enqueues all the HelidonApps when an image policy changes,
+// the namespace selector of the policy may match any of them. They are listed from the cache, which only holds
+// the HelidonApps of the watched namespaces.
+func imagePolicyRequestMapper(c client.Reader) handler.ToRequestsFunc {
+	return func(a handler.MapObject) []reconcile.Request {
+		apps := &verrazzanov1.HelidonAppList{}
+		err := c.List(context.TODO(), apps)
+		if err != nil {
+			zap.S().Errorf("Failed to list HelidonApps, Error: %s", err.Error())
+			return nil
+		}
+		var requests []reconcile.Request
+		for _, app := range apps.Items {
+			requests = append(requests, reconcile.Request{
+				NamespacedName: types.NamespacedName{Namespace: app.Namespace, Name: app.Name},
+			})
+		}
+		return requests
+	}
+}
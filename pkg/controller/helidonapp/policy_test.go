@@ -0,0 +1,75 @@
+// Copyright (c) 2020, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package helidonapp
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	vz "github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// Test that a HelidonApp violating an image policy is not deployed until the policy allows it
+func TestReconcileImagePolicyViolation(t *testing.T) {
+	s := newTestScheme(t)
+	app := newTestApp("myapp", "crns", "crns")
+	app.Spec.Container.Image = "docker.io/myapp:1.0"
+	policy := &vz.HelidonAppImagePolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "registries"},
+		Spec:       vz.HelidonAppImagePolicySpec{AllowedRegistries: []string{"container-registry.oracle.com"}},
+	}
+	c := fake.NewFakeClientWithScheme(s, app, policy)
+	r := &ReconcileHelidonApp{client: c, scheme: s}
+	request := reconcile.Request{NamespacedName: types.NamespacedName{Namespace: "crns", Name: "myapp"}}
+
+	_, err := r.Reconcile(request)
+	assert.NoError(t, err)
+	found := &vz.HelidonApp{}
+	assert.NoError(t, c.Get(context.TODO(), request.NamespacedName, found))
+	assert.Equal(t, "Failed", found.Status.State)
+	condition := findCondition(&found.Status, vz.ConditionPolicyViolation)
+	if assert.NotNil(t, condition) {
+		assert.Equal(t, corev1.ConditionTrue, condition.Status)
+		assert.Equal(t, reasonImagePolicy, condition.Reason)
+		assert.Contains(t, condition.Message, "docker.io/myapp:1.0")
+	}
+	err = c.Get(context.TODO(), types.NamespacedName{Namespace: "crns", Name: "myapp"}, &appsv1.Deployment{})
+	assert.True(t, errors.IsNotFound(err), "Expected Deployment not to be created")
+
+	// The first reconcile creates the namespace and requeues
+	assert.NoError(t, c.Delete(context.TODO(), policy))
+	for i := 0; i < 2; i++ {
+		_, err = r.Reconcile(request)
+		assert.NoError(t, err)
+	}
+	found = &vz.HelidonApp{}
+	assert.NoError(t, c.Get(context.TODO(), request.NamespacedName, found))
+	condition = findCondition(&found.Status, vz.ConditionPolicyViolation)
+	if assert.NotNil(t, condition) {
+		assert.Equal(t, corev1.ConditionFalse, condition.Status)
+	}
+	assert.NoError(t, c.Get(context.TODO(), types.NamespacedName{Namespace: "crns", Name: "myapp"}, &appsv1.Deployment{}))
+}
+
+// Test mapping an image policy to all the HelidonApps
+func TestImagePolicyRequestMapper(t *testing.T) {
+	s := newTestScheme(t)
+	c := fake.NewFakeClientWithScheme(s, newTestApp("app1", "ns1", "ns1"), newTestApp("app2", "ns2", "ns2"))
+	policy := &vz.HelidonAppImagePolicy{ObjectMeta: metav1.ObjectMeta{Name: "policy"}}
+
+	requests := imagePolicyRequestMapper(c)(handler.MapObject{Meta: policy, Object: policy})
+	assert.ElementsMatch(t, []reconcile.Request{
+		{NamespacedName: types.NamespacedName{Namespace: "ns1", Name: "app1"}},
+		{NamespacedName: types.NamespacedName{Namespace: "ns2", Name: "app2"}},
+	}, requests)
+}
@@ -0,0 +1,24 @@
+// Copyright (c) 2020, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package helidonapp
+
+import (
+	verrazzanov1 "github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1"
+	"github.com/verrazzano/verrazzano-helidon-app-operator/pkg/render"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// updateRestartedAt stamps spec.restartAt on the pod template in place, returns true if it changed, which
+// restarts the pods. Removing spec.restartAt leaves the pods alone.
+func updateRestartedAt(cr *verrazzanov1.HelidonApp, meta *metav1.ObjectMeta) bool {
+	restartedAt := render.RestartedAt(cr)
+	if restartedAt == "" || meta.Annotations[render.RestartedAtAnnotation] == restartedAt {
+		return false
+	}
+	if meta.Annotations == nil {
+		meta.Annotations = make(map[string]string)
+	}
+	meta.Annotations[render.RestartedAtAnnotation] = restartedAt
+	return true
+}
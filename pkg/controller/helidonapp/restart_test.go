@@ -0,0 +1,58 @@
+// Copyright (c) 2020, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package helidonapp
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	vz "github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1"
+	"github.com/verrazzano/verrazzano-helidon-app-operator/pkg/render"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// Test that changing spec.restartAt stamps the pod template and records the restart
+func TestReconcileRestartAt(t *testing.T) {
+	s := newTestScheme(t)
+	app := newTestApp("myapp", "myns", "myns")
+	app.Spec.Container.Image = "myapp:1.0"
+	c := fake.NewFakeClientWithScheme(s, app)
+	r := &ReconcileHelidonApp{client: c, scheme: s}
+	request := reconcile.Request{NamespacedName: types.NamespacedName{Namespace: "myns", Name: "myapp"}}
+
+	// Reconciles create the namespace, the Deployment and the Service one at a time
+	for i := 0; i < 3; i++ {
+		_, err := r.Reconcile(request)
+		assert.NoError(t, err)
+	}
+	found := &vz.HelidonApp{}
+	assert.NoError(t, c.Get(context.TODO(), request.NamespacedName, found))
+	assert.Nil(t, found.Status.LastRestartTime)
+
+	restartAt := metav1.NewTime(time.Date(2020, 6, 1, 12, 0, 0, 0, time.UTC))
+	found.Spec.RestartAt = &restartAt
+	assert.NoError(t, c.Update(context.TODO(), found))
+	_, err := r.Reconcile(request)
+	assert.NoError(t, err)
+
+	deployment := &appsv1.Deployment{}
+	assert.NoError(t, c.Get(context.TODO(), request.NamespacedName, deployment))
+	assert.Equal(t, "2020-06-01T12:00:00Z", deployment.Spec.Template.Annotations[render.RestartedAtAnnotation])
+	found = &vz.HelidonApp{}
+	assert.NoError(t, c.Get(context.TODO(), request.NamespacedName, found))
+	if assert.NotNil(t, found.Status.LastRestartTime) {
+		assert.Equal(t, "Helidon application deployment restarted", found.Status.LastActionMessage)
+	}
+
+	// Removing spec.restartAt doesn't restart the pods again
+	found.Spec.RestartAt = nil
+	assert.False(t, updateRestartedAt(found, &deployment.Spec.Template.ObjectMeta))
+	assert.Equal(t, "2020-06-01T12:00:00Z", deployment.Spec.Template.Annotations[render.RestartedAtAnnotation])
+}
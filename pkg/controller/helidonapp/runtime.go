@@ -0,0 +1,28 @@
+// Copyright (c) 2020, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package helidonapp
+
+import (
+	verrazzanov1 "github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1"
+	"github.com/verrazzano/verrazzano-helidon-app-operator/pkg/render"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// updateRuntimeLabel sets the runtime label of the pod template in place, or removes it when spec.runtime is
+// not set. Returns true if the label changed.
+func updateRuntimeLabel(cr *verrazzanov1.HelidonApp, meta *metav1.ObjectMeta) bool {
+	current, exists := meta.Labels[render.RuntimeLabel]
+	if cr.Spec.Runtime == "" {
+		delete(meta.Labels, render.RuntimeLabel)
+		return exists
+	}
+	if exists && current == string(cr.Spec.Runtime) {
+		return false
+	}
+	if meta.Labels == nil {
+		meta.Labels = make(map[string]string)
+	}
+	meta.Labels[render.RuntimeLabel] = string(cr.Spec.Runtime)
+	return true
+}
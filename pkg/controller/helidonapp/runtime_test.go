@@ -0,0 +1,54 @@
+// Copyright (c) 2020, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package helidonapp
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	vz "github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1"
+	"github.com/verrazzano/verrazzano-helidon-app-operator/pkg/render"
+	appsv1 "k8s.io/api/apps/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// Test that switching the runtime updates the pod label and the resources of the Deployment
+func TestReconcileRuntime(t *testing.T) {
+	s := newTestScheme(t)
+	app := newTestApp("myapp", "myns", "myns")
+	app.Spec.Container.Image = "myapp:1.0"
+	app.Spec.Runtime = vz.HelidonRuntimeJVM
+	c := fake.NewFakeClientWithScheme(s, app)
+	r := &ReconcileHelidonApp{client: c, scheme: s}
+	request := reconcile.Request{NamespacedName: types.NamespacedName{Namespace: "myns", Name: "myapp"}}
+
+	// Reconciles create the namespace, the Deployment and the Service one at a time
+	for i := 0; i < 3; i++ {
+		_, err := r.Reconcile(request)
+		assert.NoError(t, err)
+	}
+	deployment := &appsv1.Deployment{}
+	assert.NoError(t, c.Get(context.TODO(), request.NamespacedName, deployment))
+	assert.Equal(t, "jvm", deployment.Spec.Template.Labels[render.RuntimeLabel])
+	assert.Equal(t, "512Mi", deployment.Spec.Template.Spec.Containers[0].Resources.Requests.Memory().String())
+	assert.NotContains(t, deployment.Spec.Selector.MatchLabels, render.RuntimeLabel)
+
+	found := &vz.HelidonApp{}
+	assert.NoError(t, c.Get(context.TODO(), request.NamespacedName, found))
+	found.Spec.Runtime = vz.HelidonRuntimeNative
+	assert.NoError(t, c.Update(context.TODO(), found))
+	_, err := r.Reconcile(request)
+	assert.NoError(t, err)
+	deployment = &appsv1.Deployment{}
+	assert.NoError(t, c.Get(context.TODO(), request.NamespacedName, deployment))
+	assert.Equal(t, "native", deployment.Spec.Template.Labels[render.RuntimeLabel])
+	assert.Equal(t, "64Mi", deployment.Spec.Template.Spec.Containers[0].Resources.Requests.Memory().String())
+
+	found.Spec.Runtime = ""
+	assert.True(t, updateRuntimeLabel(found, &deployment.Spec.Template.ObjectMeta))
+	assert.NotContains(t, deployment.Spec.Template.Labels, render.RuntimeLabel)
+}
@@ -0,0 +1,72 @@
+// Copyright (c) 2020, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package helidonapp
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	verrazzanov1 "github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1"
+	"github.com/verrazzano/verrazzano-helidon-app-operator/pkg/schedule"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// now returns the current time of the reconciler
+func (r *ReconcileHelidonApp) now() time.Time {
+	if r.clock != nil {
+		return r.clock()
+	}
+	return time.Now()
+}
+
+// evaluateSchedule returns the replicas of the HelidonApp considering spec.suspend and spec.schedule, the
+// active schedule entry and the time the next window starts or ends
+func (r *ReconcileHelidonApp) evaluateSchedule(cr *verrazzanov1.HelidonApp) (int32, *verrazzanov1.ScheduleEntry, time.Time, error) {
+	active, next, err := schedule.Active(cr.Spec.Schedule, r.now())
+	if err != nil {
+		return 0, nil, time.Time{}, err
+	}
+	return schedule.Replicas(cr, active), active, next, nil
+}
+
+// updateScheduleStatus records the active schedule entry and the next window boundary when they changed
+func (r *ReconcileHelidonApp) updateScheduleStatus(reqLogger *zap.SugaredLogger, cr *verrazzanov1.HelidonApp, active *verrazzanov1.ScheduleEntry, next time.Time) error {
+	activeName := ""
+	if active != nil {
+		activeName = active.Name
+	}
+	var nextTime *metav1.Time
+	if !next.IsZero() {
+		nextTime = &metav1.Time{Time: next}
+	}
+	if cr.Status.ActiveSchedule == activeName && cr.Status.NextScheduleTime.Equal(nextTime) {
+		return nil
+	}
+	if cr.Status.ActiveSchedule != activeName {
+		reqLogger.Infof("Active schedule changed from %q to %q, Name: %s Namespace: %s", cr.Status.ActiveSchedule, activeName, cr.Name, cr.Namespace)
+	}
+	cr.Status.ActiveSchedule = activeName
+	cr.Status.NextScheduleTime = nextTime
+	err := r.client.Status().Update(context.TODO(), cr)
+	if err != nil {
+		reqLogger.Errorf("Failed to update the schedule status, Name: %s Namespace: %s, Error: %s", cr.Name, cr.Namespace, err.Error())
+	}
+	return err
+}
+
+// requeueAtNextSchedule makes sure the result requeues the HelidonApp when the next window starts or ends
+func (r *ReconcileHelidonApp) requeueAtNextSchedule(result reconcile.Result, next time.Time) reconcile.Result {
+	if next.IsZero() {
+		return result
+	}
+	// Round up to the second so the requeue doesn't happen just before the boundary
+	after := next.Sub(r.now()).Truncate(time.Second) + time.Second
+	if result.RequeueAfter == 0 || after < result.RequeueAfter {
+		result.RequeueAfter = after
+	}
+	return result
+}
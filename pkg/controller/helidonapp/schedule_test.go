@@ -0,0 +1,99 @@
+// Copyright (c) 2020, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package helidonapp
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	vz "github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// Test that schedule windows override the replicas, requeue at the next boundary and show in the status,
+// and that spec.suspend scales to zero while keeping the Service
+func TestReconcileSchedule(t *testing.T) {
+	s := newTestScheme(t)
+	three := int32(3)
+	app := newTestApp("myapp", "myns", "myns")
+	app.Spec.Container.Image = "myapp:1.0"
+	app.Spec.Scaling.Replicas = &three
+	app.Spec.Schedule = []vz.ScheduleEntry{{Name: "nights", Start: "0 20 * * *", End: "0 7 * * *", Suspend: true}}
+	c := fake.NewFakeClientWithScheme(s, app)
+	now := time.Date(2020, 6, 1, 12, 0, 0, 0, time.UTC)
+	r := &ReconcileHelidonApp{client: c, scheme: s, clock: func() time.Time { return now }}
+	request := reconcile.Request{NamespacedName: types.NamespacedName{Namespace: "myns", Name: "myapp"}}
+
+	// Reconciles create the namespace, the Deployment and the Service one at a time, then requeue at the boundary
+	var result reconcile.Result
+	var err error
+	for i := 0; i < 4; i++ {
+		result, err = r.Reconcile(request)
+		assert.NoError(t, err)
+	}
+	assert.Equal(t, 8*time.Hour+time.Second, result.RequeueAfter)
+	assertReplicas(t, c, 3)
+	found := &vz.HelidonApp{}
+	assert.NoError(t, c.Get(context.TODO(), request.NamespacedName, found))
+	assert.Empty(t, found.Status.ActiveSchedule)
+	assert.Equal(t, time.Date(2020, 6, 1, 20, 0, 0, 0, time.UTC), found.Status.NextScheduleTime.UTC())
+
+	now = time.Date(2020, 6, 1, 20, 0, 1, 0, time.UTC)
+	result, err = r.Reconcile(request)
+	assert.NoError(t, err)
+	assert.Equal(t, 11*time.Hour, result.RequeueAfter)
+	assertReplicas(t, c, 0)
+	found = &vz.HelidonApp{}
+	assert.NoError(t, c.Get(context.TODO(), request.NamespacedName, found))
+	assert.Equal(t, "nights", found.Status.ActiveSchedule)
+
+	// Suspended outside of the window
+	now = time.Date(2020, 6, 2, 12, 0, 0, 0, time.UTC)
+	found.Spec.Suspend = true
+	assert.NoError(t, c.Update(context.TODO(), found))
+	_, err = r.Reconcile(request)
+	assert.NoError(t, err)
+	assertReplicas(t, c, 0)
+	assert.NoError(t, c.Get(context.TODO(), request.NamespacedName, &corev1.Service{}))
+}
+
+// Test that an invalid schedule fails the HelidonApp once, without updating the status on every reconcile
+func TestReconcileInvalidSchedule(t *testing.T) {
+	s := newTestScheme(t)
+	app := newTestApp("myapp", "myns", "myns")
+	app.Spec.Schedule = []vz.ScheduleEntry{{Name: "nights", Start: "0 25 * * *", End: "0 7 * * *", Suspend: true}}
+	c := fake.NewFakeClientWithScheme(s, app)
+	r := &ReconcileHelidonApp{client: c, scheme: s}
+	request := reconcile.Request{NamespacedName: types.NamespacedName{Namespace: "myns", Name: "myapp"}}
+
+	for i := 0; i < 2; i++ {
+		_, err := r.Reconcile(request)
+		assert.NoError(t, err)
+	}
+	found := &vz.HelidonApp{}
+	assert.NoError(t, c.Get(context.TODO(), request.NamespacedName, found))
+	assert.Equal(t, "Failed", found.Status.State)
+	assert.Contains(t, found.Status.LastActionMessage, "Helidon application schedule is invalid")
+
+	_, err := r.Reconcile(request)
+	assert.NoError(t, err)
+	again := &vz.HelidonApp{}
+	assert.NoError(t, c.Get(context.TODO(), request.NamespacedName, again))
+	assert.Equal(t, found.ResourceVersion, again.ResourceVersion, "Expected the status not to be updated again")
+}
+
+func assertReplicas(t *testing.T, c client.Client, expected int32) {
+	deployment := &appsv1.Deployment{}
+	assert.NoError(t, c.Get(context.TODO(), types.NamespacedName{Namespace: "myns", Name: "myapp"}, deployment))
+	if assert.NotNil(t, deployment.Spec.Replicas) {
+		assert.Equal(t, expected, *deployment.Spec.Replicas)
+	}
+}
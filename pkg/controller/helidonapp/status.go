@@ -0,0 +1,97 @@
+// Copyright (c) 2020, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package helidonapp
+
+import (
+	"context"
+	"reflect"
+
+	"go.uber.org/zap"
+
+	verrazzanov1 "github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// findCondition returns the condition of the given type, or nil if the status doesn't have it
+func findCondition(status *verrazzanov1.HelidonAppStatus, conditionType verrazzanov1.ConditionType) *verrazzanov1.Condition {
+	for i := range status.Conditions {
+		if status.Conditions[i].Type == conditionType {
+			return &status.Conditions[i]
+		}
+	}
+	return nil
+}
+
+// setCondition adds or updates a condition of the status. The transition time only changes when the
+// condition status changes.
+func setCondition(status *verrazzanov1.HelidonAppStatus, conditionType verrazzanov1.ConditionType, conditionStatus corev1.ConditionStatus, reason string, message string) {
+	existing := findCondition(status, conditionType)
+	if existing == nil {
+		status.Conditions = append(status.Conditions, verrazzanov1.Condition{
+			Type:               conditionType,
+			Status:             conditionStatus,
+			LastTransitionTime: metav1.Now(),
+			Reason:             reason,
+			Message:            message,
+		})
+		return
+	}
+	if existing.Status != conditionStatus {
+		existing.Status = conditionStatus
+		existing.LastTransitionTime = metav1.Now()
+	}
+	existing.Reason = reason
+	existing.Message = message
+}
+
+// removeCondition removes the condition of the given type from the status
+func removeCondition(status *verrazzanov1.HelidonAppStatus, conditionType verrazzanov1.ConditionType) {
+	var conditions []verrazzanov1.Condition
+	for _, c := range status.Conditions {
+		if c.Type != conditionType {
+			conditions = append(conditions, c)
+		}
+	}
+	status.Conditions = conditions
+}
+
+// setReplicaStatus sets the replica counts, the pod selector and the Ready condition from the deployment
+func setReplicaStatus(status *verrazzanov1.HelidonAppStatus, deployment *appsv1.Deployment) {
+	status.Replicas = deployment.Status.Replicas
+	status.ReadyReplicas = deployment.Status.ReadyReplicas
+	status.Selector = metav1.FormatLabelSelector(deployment.Spec.Selector)
+
+	if isDeploymentReady(deployment) {
+		setCondition(status, verrazzanov1.ConditionReady, corev1.ConditionTrue, "DeploymentReady",
+			"All desired replicas of the Helidon application are available")
+	} else {
+		setCondition(status, verrazzanov1.ConditionReady, corev1.ConditionFalse, "DeploymentNotReady",
+			"Waiting for the desired replicas of the Helidon application to be available")
+	}
+}
+
+// updateReplicaStatus updates the replica, pod and debug status of the HelidonApp when the deployment, its pods
+// or spec.debug changed it
+func (r *ReconcileHelidonApp) updateReplicaStatus(reqLogger *zap.SugaredLogger, cr *verrazzanov1.HelidonApp, deployment *appsv1.Deployment) error {
+	pods, err := r.listPods(deployment)
+	if err != nil {
+		reqLogger.Errorf("Failed to list pods, Name: %s Namespace: %s, Error: %s", deployment.Name, deployment.Namespace, err.Error())
+		return err
+	}
+	status := cr.Status.DeepCopy()
+	setReplicaStatus(status, deployment)
+	setPodStatus(status, podIssues(pods))
+	setDebugStatus(status, cr)
+	if reflect.DeepEqual(*status, cr.Status) {
+		return nil
+	}
+	cr.Status = *status
+	err = r.client.Status().Update(context.TODO(), cr)
+	if err != nil {
+		reqLogger.Errorf("Failed to update HelidonApp status, Name: %s Namespace: %s, Error: %s", cr.Name, cr.Namespace, err.Error())
+	}
+	return err
+}
@@ -0,0 +1,71 @@
+// Copyright (c) 2020, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package helidonapp
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	vz "github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1"
+	"github.com/verrazzano/verrazzano-helidon-app-operator/pkg/render"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+// Test that a condition keeps its transition time until its status changes
+func TestSetCondition(t *testing.T) {
+	status := &vz.HelidonAppStatus{}
+	setCondition(status, vz.ConditionReady, corev1.ConditionFalse, "NotReady", "not ready")
+	assert.Equal(t, 1, len(status.Conditions))
+	transition := status.Conditions[0].LastTransitionTime
+
+	setCondition(status, vz.ConditionReady, corev1.ConditionFalse, "StillNotReady", "still not ready")
+	assert.Equal(t, 1, len(status.Conditions))
+	assert.Equal(t, transition, status.Conditions[0].LastTransitionTime, "Expected transition time to be kept")
+	assert.Equal(t, "StillNotReady", status.Conditions[0].Reason)
+
+	setCondition(status, vz.ConditionReady, corev1.ConditionTrue, "Ready", "ready")
+	assert.Equal(t, corev1.ConditionTrue, findCondition(status, vz.ConditionReady).Status)
+
+	removeCondition(status, vz.ConditionReady)
+	assert.Nil(t, findCondition(status, vz.ConditionReady))
+}
+
+// Test that the replica counts, the selector and the Ready condition are reported from the deployment
+func TestUpdateReplicaStatus(t *testing.T) {
+	s := newTestScheme(t)
+	app := newTestApp("myapp", "crns", "crns")
+	c := fake.NewFakeClientWithScheme(s, app)
+	r := &ReconcileHelidonApp{client: c, scheme: s}
+
+	deploy := render.Deployment(app)
+	assert.NoError(t, r.updateReplicaStatus(zap.S(), app, deploy))
+	found := &vz.HelidonApp{}
+	assert.NoError(t, c.Get(context.TODO(), types.NamespacedName{Namespace: "crns", Name: "myapp"}, found))
+	assert.Equal(t, int32(0), found.Status.Replicas)
+	assert.Equal(t, int32(0), found.Status.ReadyReplicas)
+	assert.Equal(t, "app=myapp", found.Status.Selector)
+	assert.Equal(t, corev1.ConditionFalse, findCondition(&found.Status, vz.ConditionReady).Status)
+
+	deploy.Status.Replicas = 1
+	deploy.Status.ReadyReplicas = 1
+	deploy.Status.UpdatedReplicas = 1
+	deploy.Status.AvailableReplicas = 1
+	assert.NoError(t, r.updateReplicaStatus(zap.S(), found, deploy))
+	assert.NoError(t, c.Get(context.TODO(), types.NamespacedName{Namespace: "crns", Name: "myapp"}, found))
+	assert.Equal(t, int32(1), found.Status.Replicas)
+	assert.Equal(t, int32(1), found.Status.ReadyReplicas)
+	assert.Equal(t, corev1.ConditionTrue, findCondition(&found.Status, vz.ConditionReady).Status)
+
+	// scaled to three replicas while the deployment still runs one
+	replicas := int32(3)
+	deploy.Spec.Replicas = &replicas
+	assert.NoError(t, r.updateReplicaStatus(zap.S(), found, deploy))
+	assert.NoError(t, c.Get(context.TODO(), types.NamespacedName{Namespace: "crns", Name: "myapp"}, found))
+	assert.Equal(t, int32(1), found.Status.Replicas)
+	assert.Equal(t, corev1.ConditionFalse, findCondition(&found.Status, vz.ConditionReady).Status)
+}
@@ -0,0 +1,44 @@
+// Copyright (c) 2020, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package helidonapp
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	verrazzanov1 "github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// grantRequestMapper returns a mapper that enqueues the HelidonApps deploying to the namespace of a
+// HelidonAppNamespaceGrant from one of its source namespaces
+func grantRequestMapper(c client.Client) handler.ToRequestsFunc {
+	return func(a handler.MapObject) []reconcile.Request {
+		grant, ok := a.Object.(*verrazzanov1.HelidonAppNamespaceGrant)
+		if !ok {
+			return nil
+		}
+		var requests []reconcile.Request
+		for _, ns := range grant.Spec.SourceNamespaces {
+			apps := &verrazzanov1.HelidonAppList{}
+			err := c.List(context.TODO(), apps, client.InNamespace(ns))
+			if err != nil {
+				zap.S().Errorf("Failed to list HelidonApps in namespace %s, Error: %s", ns, err.Error())
+				continue
+			}
+			for _, app := range apps.Items {
+				if app.Spec.Namespace == grant.Namespace {
+					requests = append(requests, reconcile.Request{
+						NamespacedName: types.NamespacedName{Namespace: app.Namespace, Name: app.Name},
+					})
+				}
+			}
+		}
+		return requests
+	}
+}
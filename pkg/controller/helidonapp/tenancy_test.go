@@ -0,0 +1,84 @@
+// Copyright (c) 2020, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package helidonapp
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	vz "github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1"
+	"github.com/verrazzano/verrazzano-helidon-app-operator/pkg/tenancy"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// Test that a HelidonApp deploying to another namespace without a grant is not reconciled
+func TestReconcileTenancyViolation(t *testing.T) {
+	s := newTestScheme(t)
+	app := newTestApp("myapp", "crns", "kube-system")
+	c := fake.NewFakeClientWithScheme(s, app)
+	r := &ReconcileHelidonApp{client: c, scheme: s}
+	request := reconcile.Request{NamespacedName: types.NamespacedName{Namespace: "crns", Name: "myapp"}}
+
+	_, err := r.Reconcile(request)
+	assert.NoError(t, err)
+	found := &vz.HelidonApp{}
+	assert.NoError(t, c.Get(context.TODO(), request.NamespacedName, found))
+	assert.Equal(t, "Failed", found.Status.State)
+	assert.Contains(t, found.Status.LastActionMessage, "tenancy policy")
+	err = c.Get(context.TODO(), types.NamespacedName{Name: "kube-system"}, &corev1.Namespace{})
+	assert.True(t, errors.IsNotFound(err), "Expected target namespace not to be created")
+}
+
+// Test that a grant allows a HelidonApp to deploy to another namespace, with the ServiceAccounts of the grant
+func TestReconcileTenancyGrant(t *testing.T) {
+	s := newTestScheme(t)
+	app := newTestApp("myapp", "crns", "targetns")
+	app.Spec.ServiceAccountName = "mysa"
+	grant := newTestGrant("targetns", "crns")
+	c := fake.NewFakeClientWithScheme(s, app, grant)
+	r := &ReconcileHelidonApp{client: c, scheme: s}
+
+	allowed, err := r.checkPolicies(zap.S(), app)
+	assert.NoError(t, err)
+	assert.False(t, allowed, "Expected ServiceAccount not to be allowed")
+
+	grant.Spec.ServiceAccountNames = []string{"mysa"}
+	assert.NoError(t, c.Update(context.TODO(), grant))
+	allowed, err = r.checkPolicies(zap.S(), app)
+	assert.NoError(t, err)
+	assert.True(t, allowed, "Expected ServiceAccount of the grant to be allowed")
+
+	r.tenancy = tenancy.Policy{AllowedServiceAccounts: []string{"othersa"}}
+	app.Spec.ServiceAccountName = "othersa"
+	allowed, err = r.checkPolicies(zap.S(), app)
+	assert.NoError(t, err)
+	assert.True(t, allowed, "Expected operator allowed ServiceAccount to be allowed")
+}
+
+// Test mapping a grant to the HelidonApps deploying to its namespace
+func TestGrantRequestMapper(t *testing.T) {
+	s := newTestScheme(t)
+	granted := newTestApp("granted", "crns", "targetns")
+	other := newTestApp("other", "crns", "crns")
+	c := fake.NewFakeClientWithScheme(s, granted, other)
+	grant := newTestGrant("targetns", "crns")
+
+	requests := grantRequestMapper(c)(handler.MapObject{Meta: grant, Object: grant})
+	assert.Equal(t, []reconcile.Request{{NamespacedName: types.NamespacedName{Namespace: "crns", Name: "granted"}}}, requests)
+}
+
+func newTestGrant(namespace string, sourceNamespace string) *vz.HelidonAppNamespaceGrant {
+	return &vz.HelidonAppNamespaceGrant{
+		ObjectMeta: metav1.ObjectMeta{Name: "grant", Namespace: namespace},
+		Spec:       vz.HelidonAppNamespaceGrantSpec{SourceNamespaces: []string{sourceNamespace}},
+	}
+}
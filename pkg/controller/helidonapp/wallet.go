@@ -0,0 +1,36 @@
+// Copyright (c) 2020, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package helidonapp
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	verrazzanov1 "github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1"
+	"github.com/verrazzano/verrazzano-helidon-app-operator/pkg/database"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// checkWallet checks the Secret of spec.database.wallet holds the required wallet files. Returns a description
+// of the problem, empty when the wallet can be rolled out.
+func (r *ReconcileHelidonApp) checkWallet(cr *verrazzanov1.HelidonApp) (string, error) {
+	wallet := database.Wallet(cr)
+	if wallet == nil {
+		return "", nil
+	}
+	secret := &corev1.Secret{}
+	err := r.client.Get(context.TODO(), types.NamespacedName{Namespace: cr.Spec.Namespace, Name: wallet.Secret}, secret)
+	if errors.IsNotFound(err) {
+		return fmt.Sprintf("Secret %s/%s not found", cr.Spec.Namespace, wallet.Secret), nil
+	} else if err != nil {
+		return "", err
+	}
+	if missing := database.MissingWalletFiles(secret); len(missing) > 0 {
+		return fmt.Sprintf("Secret %s/%s is missing %s", cr.Spec.Namespace, wallet.Secret, strings.Join(missing, ", ")), nil
+	}
+	return "", nil
+}
@@ -0,0 +1,80 @@
+// Copyright (c) 2020, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package helidonapp
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	vz "github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// Test that the Deployment isn't rolled out until the wallet Secret holds the required files
+func TestReconcileWallet(t *testing.T) {
+	s := newTestScheme(t)
+	app := newTestApp("myapp", "myns", "myns")
+	app.Spec.Database = &vz.DatabaseSpec{Wallet: &vz.WalletSpec{Secret: "atp-wallet"}}
+	c := fake.NewFakeClientWithScheme(s, app)
+	r := &ReconcileHelidonApp{client: c, scheme: s}
+	request := reconcile.Request{NamespacedName: types.NamespacedName{Namespace: "myns", Name: "myapp"}}
+
+	// The first reconcile creates the namespace, the next ones find the wallet is missing
+	for i := 0; i < 2; i++ {
+		_, err := r.Reconcile(request)
+		assert.NoError(t, err)
+	}
+	found := &vz.HelidonApp{}
+	assert.NoError(t, c.Get(context.TODO(), request.NamespacedName, found))
+	assert.Equal(t, "Failed", found.Status.State)
+	assert.Equal(t, "Helidon application database wallet is invalid: Secret myns/atp-wallet not found", found.Status.LastActionMessage)
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "atp-wallet", Namespace: "myns"},
+		Data:       map[string][]byte{"cwallet.sso": []byte("sso")},
+	}
+	assert.NoError(t, c.Create(context.TODO(), secret))
+	_, err := r.Reconcile(request)
+	assert.NoError(t, err)
+	found = &vz.HelidonApp{}
+	assert.NoError(t, c.Get(context.TODO(), request.NamespacedName, found))
+	assert.Equal(t, "Helidon application database wallet is invalid: Secret myns/atp-wallet is missing tnsnames.ora", found.Status.LastActionMessage)
+	assert.True(t, errors.IsNotFound(c.Get(context.TODO(), request.NamespacedName, &appsv1.Deployment{})))
+
+	secret.Data["tnsnames.ora"] = []byte("db_high = (description= ...)")
+	assert.NoError(t, c.Update(context.TODO(), secret))
+	_, err = r.Reconcile(request)
+	assert.NoError(t, err)
+	deployment := &appsv1.Deployment{}
+	assert.NoError(t, c.Get(context.TODO(), request.NamespacedName, deployment))
+	volumes := deployment.Spec.Template.Spec.Volumes
+	if assert.Len(t, volumes, 1) {
+		assert.Equal(t, "atp-wallet", volumes[0].Secret.SecretName)
+	}
+	assert.Equal(t, []configReference{{"Secret", "atp-wallet"}}, configReferences(found))
+
+	// Switching to another wallet mounts the new Secret in the volume of the same name
+	rotated := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "atp-wallet-2", Namespace: "myns"}, Data: secret.Data}
+	assert.NoError(t, c.Create(context.TODO(), rotated))
+	found = &vz.HelidonApp{}
+	assert.NoError(t, c.Get(context.TODO(), request.NamespacedName, found))
+	found.Spec.Database.Wallet.Secret = "atp-wallet-2"
+	assert.NoError(t, c.Update(context.TODO(), found))
+	for i := 0; i < 2; i++ {
+		_, err = r.Reconcile(request)
+		assert.NoError(t, err)
+	}
+	assert.NoError(t, c.Get(context.TODO(), request.NamespacedName, deployment))
+	volumes = deployment.Spec.Template.Spec.Volumes
+	if assert.Len(t, volumes, 1) {
+		assert.Equal(t, "atp-wallet-2", volumes[0].Secret.SecretName)
+	}
+}
@@ -0,0 +1,76 @@
+// Copyright (c) 2020, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+// Package database holds the conventions of the Oracle Database access of HelidonApps, like where the wallet
+// is mounted and how the Oracle JDBC driver finds it.
+package database
+
+import (
+	"fmt"
+	"path"
+	"strings"
+
+	verrazzanov1 "github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1"
+	corev1 "k8s.io/api/core/v1"
+)
+
+const (
+	// DefaultWalletMountPath is the directory of the wallet when spec.database.wallet.mountPath is not set
+	DefaultWalletMountPath = "/wallet"
+	// TNSAdminEnv is the environment variable giving the Oracle JDBC driver the directory of tnsnames.ora
+	TNSAdminEnv = "TNS_ADMIN"
+)
+
+// RequiredWalletFiles are the files of a wallet the Oracle JDBC driver needs to connect without a password
+var RequiredWalletFiles = []string{"cwallet.sso", "tnsnames.ora"}
+
+// Wallet returns spec.database.wallet, nil when not set
+func Wallet(cr *verrazzanov1.HelidonApp) *verrazzanov1.WalletSpec {
+	if cr.Spec.Database == nil {
+		return nil
+	}
+	return cr.Spec.Database.Wallet
+}
+
+// WalletMountPath returns the directory of the wallet in the main container
+func WalletMountPath(cr *verrazzanov1.HelidonApp) string {
+	if wallet := Wallet(cr); wallet != nil && wallet.MountPath != "" {
+		return wallet.MountPath
+	}
+	return DefaultWalletMountPath
+}
+
+// WalletJVMOptions returns the system properties pointing the Oracle JDBC driver to the wallet
+func WalletJVMOptions(cr *verrazzanov1.HelidonApp) []string {
+	dir := WalletMountPath(cr)
+	return []string{
+		"-Doracle.net.tns_admin=" + dir,
+		fmt.Sprintf("-Doracle.net.wallet_location=(SOURCE=(METHOD=FILE)(METHOD_DATA=(DIRECTORY=%s)))", dir),
+	}
+}
+
+// MissingWalletFiles returns the required wallet files the Secret doesn't hold
+func MissingWalletFiles(secret *corev1.Secret) []string {
+	var missing []string
+	for _, file := range RequiredWalletFiles {
+		if len(secret.Data[file]) == 0 {
+			missing = append(missing, file)
+		}
+	}
+	return missing
+}
+
+// Validate checks spec.database
+func Validate(cr *verrazzanov1.HelidonApp) error {
+	wallet := Wallet(cr)
+	if wallet == nil {
+		return nil
+	}
+	if wallet.Secret == "" {
+		return fmt.Errorf("the wallet needs a Secret")
+	}
+	if wallet.MountPath != "" && (!path.IsAbs(wallet.MountPath) || strings.ContainsAny(wallet.MountPath, " ()")) {
+		return fmt.Errorf("invalid wallet mount path %s, expected an absolute path without spaces or parentheses", wallet.MountPath)
+	}
+	return nil
+}
@@ -0,0 +1,52 @@
+// Copyright (c) 2020, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package database
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	verrazzanov1 "github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// Test the mount path and system properties of a wallet
+func TestWalletJVMOptions(t *testing.T) {
+	cr := &verrazzanov1.HelidonApp{}
+	assert.Nil(t, Wallet(cr))
+	cr.Spec.Database = &verrazzanov1.DatabaseSpec{Wallet: &verrazzanov1.WalletSpec{Secret: "atp-wallet"}}
+	assert.Equal(t, "/wallet", WalletMountPath(cr))
+	assert.Equal(t, []string{"-Doracle.net.tns_admin=/wallet",
+		"-Doracle.net.wallet_location=(SOURCE=(METHOD=FILE)(METHOD_DATA=(DIRECTORY=/wallet)))"}, WalletJVMOptions(cr))
+
+	cr.Spec.Database.Wallet.MountPath = "/opt/oracle/wallet"
+	assert.Equal(t, "/opt/oracle/wallet", WalletMountPath(cr))
+	assert.Equal(t, "-Doracle.net.tns_admin=/opt/oracle/wallet", WalletJVMOptions(cr)[0])
+}
+
+// Test finding the required files missing from a wallet Secret
+func TestMissingWalletFiles(t *testing.T) {
+	secret := &corev1.Secret{}
+	assert.Equal(t, []string{"cwallet.sso", "tnsnames.ora"}, MissingWalletFiles(secret))
+	secret.Data = map[string][]byte{"cwallet.sso": []byte("sso"), "tnsnames.ora": {}}
+	assert.Equal(t, []string{"tnsnames.ora"}, MissingWalletFiles(secret))
+	secret.Data["tnsnames.ora"] = []byte("db_high = (description= ...)")
+	assert.Empty(t, MissingWalletFiles(secret))
+}
+
+// Test validating spec.database
+func TestValidate(t *testing.T) {
+	cr := &verrazzanov1.HelidonApp{}
+	assert.NoError(t, Validate(cr))
+	cr.Spec.Database = &verrazzanov1.DatabaseSpec{}
+	assert.NoError(t, Validate(cr))
+	cr.Spec.Database.Wallet = &verrazzanov1.WalletSpec{}
+	assert.EqualError(t, Validate(cr), "the wallet needs a Secret")
+	cr.Spec.Database.Wallet.Secret = "atp-wallet"
+	assert.NoError(t, Validate(cr))
+	for _, mountPath := range []string{"wallet", "/my wallet", "/wallet(1)"} {
+		cr.Spec.Database.Wallet.MountPath = mountPath
+		assert.Error(t, Validate(cr), mountPath)
+	}
+}
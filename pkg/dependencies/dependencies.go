@@ -0,0 +1,151 @@
+// Copyright (c) 2020, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+// Package dependencies builds the graph of the spec.dependsOn of HelidonApps, finds its cycles and writes it in
+// the DOT format of Graphviz.
+package dependencies
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	verrazzanov1 "github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+// WaitForImage is the image of the init containers waiting for dependencies, it needs sh and nc. Set from the
+// operator flags.
+var WaitForImage = "busybox:1.36"
+
+// WaitForContainerPrefix is the prefix of the names of the init containers waiting for dependencies
+const WaitForContainerPrefix = "wait-for-"
+
+// Key returns the namespace and name of the HelidonApp of a dependency
+func Key(cr *verrazzanov1.HelidonApp, dependency verrazzanov1.Dependency) types.NamespacedName {
+	namespace := dependency.Namespace
+	if namespace == "" {
+		namespace = cr.Namespace
+	}
+	return types.NamespacedName{Namespace: namespace, Name: dependency.Name}
+}
+
+// Graph maps HelidonApps to the HelidonApps they depend on, in the order of spec.dependsOn
+type Graph map[types.NamespacedName][]types.NamespacedName
+
+// NewGraph returns the dependency graph of HelidonApps
+func NewGraph(apps []verrazzanov1.HelidonApp) Graph {
+	graph := make(Graph)
+	for i := range apps {
+		app := &apps[i]
+		key := types.NamespacedName{Namespace: app.Namespace, Name: app.Name}
+		graph[key] = nil
+		for _, dependency := range app.Spec.DependsOn {
+			graph[key] = append(graph[key], Key(app, dependency))
+		}
+	}
+	return graph
+}
+
+// Cycle returns a cycle of HelidonApps reachable from a HelidonApp, starting and ending with the same
+// HelidonApp, or nil if there is none
+func (g Graph) Cycle(from types.NamespacedName) []types.NamespacedName {
+	visited := make(map[types.NamespacedName]bool)
+	var path []types.NamespacedName
+	var visit func(node types.NamespacedName) []types.NamespacedName
+	visit = func(node types.NamespacedName) []types.NamespacedName {
+		for i, n := range path {
+			if n == node {
+				return append(append([]types.NamespacedName{}, path[i:]...), node)
+			}
+		}
+		if visited[node] {
+			return nil
+		}
+		visited[node] = true
+		path = append(path, node)
+		for _, next := range g[node] {
+			if cycle := visit(next); cycle != nil {
+				return cycle
+			}
+		}
+		path = path[:len(path)-1]
+		return nil
+	}
+	return visit(from)
+}
+
+// FormatCycle returns a cycle as a list of HelidonApps separated by arrows
+func FormatCycle(cycle []types.NamespacedName) string {
+	names := make([]string, len(cycle))
+	for i, key := range cycle {
+		names[i] = key.String()
+	}
+	return strings.Join(names, " -> ")
+}
+
+// WriteDOT writes the graph in the DOT format, with the HelidonApps as nodes named <namespace>/<name> and an
+// edge from each HelidonApp to the HelidonApps it depends on. The nodes and edges are sorted.
+func (g Graph) WriteDOT(w io.Writer) error {
+	nodes := make(map[string]bool)
+	var edges []string
+	for from, to := range g {
+		nodes[from.String()] = true
+		for _, key := range to {
+			nodes[key.String()] = true
+			edges = append(edges, fmt.Sprintf("  %q -> %q;\n", from.String(), key.String()))
+		}
+	}
+	names := make([]string, 0, len(nodes))
+	for name := range nodes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	sort.Strings(edges)
+
+	var b strings.Builder
+	b.WriteString("digraph dependencies {\n")
+	for _, name := range names {
+		fmt.Fprintf(&b, "  %q;\n", name)
+	}
+	for _, edge := range edges {
+		b.WriteString(edge)
+	}
+	b.WriteString("}\n")
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// Validate checks spec.dependsOn
+func Validate(cr *verrazzanov1.HelidonApp) error {
+	keys := make(map[types.NamespacedName]bool)
+	containers := make(map[string]bool)
+	for _, dependency := range cr.Spec.DependsOn {
+		if errs := validation.IsDNS1123Subdomain(dependency.Name); len(errs) > 0 {
+			return fmt.Errorf("invalid dependency name %q: %s", dependency.Name, strings.Join(errs, ", "))
+		}
+		key := Key(cr, dependency)
+		if key.Namespace == cr.Namespace && key.Name == cr.Name {
+			return fmt.Errorf("the HelidonApp can't depend on itself")
+		}
+		if keys[key] {
+			return fmt.Errorf("duplicate dependency %s", key)
+		}
+		keys[key] = true
+		if !dependency.WaitFor {
+			continue
+		}
+		// The name of the HelidonApp is part of the name of the init container
+		name := WaitForContainerPrefix + dependency.Name
+		if errs := validation.IsDNS1123Label(name); len(errs) > 0 {
+			return fmt.Errorf("dependency %s can't be waited for, invalid init container name %q: %s", key, name, strings.Join(errs, ", "))
+		}
+		if containers[name] {
+			return fmt.Errorf("dependency %s can't be waited for, another dependency has the same name", key)
+		}
+		containers[name] = true
+	}
+	return nil
+}
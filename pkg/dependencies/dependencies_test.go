@@ -0,0 +1,81 @@
+// Copyright (c) 2020, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package dependencies
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	verrazzanov1 "github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// newApp returns a HelidonApp in the namespace apps depending on other HelidonApps
+func newApp(name string, dependsOn ...verrazzanov1.Dependency) verrazzanov1.HelidonApp {
+	app := verrazzanov1.HelidonApp{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "apps"}}
+	app.Spec.DependsOn = dependsOn
+	return app
+}
+
+// Test the dependency namespace defaults to the namespace of the HelidonApp
+func TestKey(t *testing.T) {
+	app := newApp("orders")
+	assert.Equal(t, types.NamespacedName{Namespace: "apps", Name: "db"}, Key(&app, verrazzanov1.Dependency{Name: "db"}))
+	assert.Equal(t, types.NamespacedName{Namespace: "data", Name: "db"}, Key(&app, verrazzanov1.Dependency{Name: "db", Namespace: "data"}))
+}
+
+// Test finding the cycles reachable from a HelidonApp
+func TestCycle(t *testing.T) {
+	graph := NewGraph([]verrazzanov1.HelidonApp{
+		newApp("frontend", verrazzanov1.Dependency{Name: "orders"}, verrazzanov1.Dependency{Name: "catalog"}),
+		newApp("orders", verrazzanov1.Dependency{Name: "catalog"}),
+		newApp("catalog"),
+	})
+	assert.Nil(t, graph.Cycle(types.NamespacedName{Namespace: "apps", Name: "frontend"}), "Expected no cycle for shared dependencies")
+	assert.Nil(t, graph.Cycle(types.NamespacedName{Namespace: "apps", Name: "unknown"}))
+
+	graph = NewGraph([]verrazzanov1.HelidonApp{
+		newApp("frontend", verrazzanov1.Dependency{Name: "orders"}),
+		newApp("orders", verrazzanov1.Dependency{Name: "payments"}),
+		newApp("payments", verrazzanov1.Dependency{Name: "orders"}),
+	})
+	cycle := graph.Cycle(types.NamespacedName{Namespace: "apps", Name: "frontend"})
+	assert.Equal(t, "apps/orders -> apps/payments -> apps/orders", FormatCycle(cycle))
+	cycle = graph.Cycle(types.NamespacedName{Namespace: "apps", Name: "payments"})
+	assert.Equal(t, "apps/payments -> apps/orders -> apps/payments", FormatCycle(cycle))
+}
+
+// Test writing the graph in the DOT format, HelidonApps that don't exist are nodes too
+func TestWriteDOT(t *testing.T) {
+	graph := NewGraph([]verrazzanov1.HelidonApp{
+		newApp("orders", verrazzanov1.Dependency{Name: "db", Namespace: "data"}, verrazzanov1.Dependency{Name: "catalog"}),
+		newApp("catalog"),
+	})
+	out := &strings.Builder{}
+	assert.NoError(t, graph.WriteDOT(out))
+	assert.Equal(t, `digraph dependencies {
+  "apps/catalog";
+  "apps/orders";
+  "data/db";
+  "apps/orders" -> "apps/catalog";
+  "apps/orders" -> "data/db";
+}
+`, out.String())
+}
+
+// Test validating spec.dependsOn
+func TestValidate(t *testing.T) {
+	app := newApp("orders", verrazzanov1.Dependency{Name: "catalog", WaitFor: true}, verrazzanov1.Dependency{Name: "orders", Namespace: "other"})
+	assert.NoError(t, Validate(&app))
+	app = newApp("orders", verrazzanov1.Dependency{Name: "orders"})
+	assert.EqualError(t, Validate(&app), "the HelidonApp can't depend on itself")
+	app = newApp("orders", verrazzanov1.Dependency{Name: "Catalog"})
+	assert.Error(t, Validate(&app))
+	app = newApp("orders", verrazzanov1.Dependency{Name: "catalog", WaitFor: true}, verrazzanov1.Dependency{Name: "catalog", Namespace: "other", WaitFor: true})
+	assert.EqualError(t, Validate(&app), "dependency other/catalog can't be waited for, another dependency has the same name")
+	app = newApp("orders", verrazzanov1.Dependency{Name: "catalog.v2", WaitFor: true})
+	assert.Error(t, Validate(&app), "Expected an invalid init container name")
+}
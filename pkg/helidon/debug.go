@@ -0,0 +1,70 @@
+// Copyright (c) 2020, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package helidon
+
+import (
+	"fmt"
+
+	verrazzanov1 "github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1"
+)
+
+const (
+	// DefaultDebugPort is the port of the JDWP agent when spec.debug.port is not set
+	DefaultDebugPort int32 = 5005
+	// ToolOptionsEnv is the environment variable passing the JVM options generated by the operator, like the
+	// JDWP agent options
+	ToolOptionsEnv = "JAVA_TOOL_OPTIONS"
+)
+
+// DebugEnabled returns true if the HelidonApp runs with the JDWP agent, which requires the jvm runtime
+func DebugEnabled(cr *verrazzanov1.HelidonApp) bool {
+	return cr.Spec.Debug != nil && cr.Spec.Debug.Enabled && Runtime(cr) == verrazzanov1.HelidonRuntimeJVM
+}
+
+// DebugPort returns the port of the JDWP agent
+func DebugPort(cr *verrazzanov1.HelidonApp) int32 {
+	if cr.Spec.Debug == nil || cr.Spec.Debug.Port == 0 {
+		return DefaultDebugPort
+	}
+	return cr.Spec.Debug.Port
+}
+
+// DebugOptions returns the JVM options loading the JDWP agent. Helidon 1 runs on Java 8, which listens on all
+// interfaces given just a port, later versions need the * host.
+func DebugOptions(cr *verrazzanov1.HelidonApp) string {
+	suspend := "n"
+	if cr.Spec.Debug != nil && cr.Spec.Debug.Suspend {
+		suspend = "y"
+	}
+	address := fmt.Sprintf("*:%d", DebugPort(cr))
+	if spec := cr.Spec.Helidon; spec != nil {
+		if major, err := MajorVersion(spec.Version); err == nil && major == 1 {
+			address = fmt.Sprint(DebugPort(cr))
+		}
+	}
+	return fmt.Sprintf("-agentlib:jdwp=transport=dt_socket,server=y,suspend=%s,address=%s", suspend, address)
+}
+
+// validateDebug checks that remote debugging is only enabled with the jvm runtime, on a port not used by the
+// application
+func validateDebug(cr *verrazzanov1.HelidonApp) error {
+	if cr.Spec.Debug == nil || !cr.Spec.Debug.Enabled {
+		return nil
+	}
+	if Runtime(cr) != verrazzanov1.HelidonRuntimeJVM {
+		return fmt.Errorf("remote debugging is not supported by the %s runtime", Runtime(cr))
+	}
+	port := DebugPort(cr)
+	if port < 1 || port > 65535 {
+		return fmt.Errorf("invalid debug port %d", port)
+	}
+	appPort := cr.Spec.Service.Port
+	if appPort == 0 {
+		appPort = 8080
+	}
+	if port == appPort {
+		return fmt.Errorf("debug port %d is already used by the application", port)
+	}
+	return nil
+}
@@ -0,0 +1,38 @@
+// Copyright (c) 2020, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package helidon
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	verrazzanov1 "github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1"
+)
+
+// Test the JDWP agent options and the validation of spec.debug
+func TestDebug(t *testing.T) {
+	cr := &verrazzanov1.HelidonApp{}
+	assert.False(t, DebugEnabled(cr))
+	assert.NoError(t, Validate(cr))
+
+	cr.Spec.Debug = &verrazzanov1.DebugSpec{Enabled: true}
+	assert.True(t, DebugEnabled(cr))
+	assert.Equal(t, "-agentlib:jdwp=transport=dt_socket,server=y,suspend=n,address=*:5005", DebugOptions(cr))
+	assert.NoError(t, Validate(cr))
+
+	cr.Spec.Debug.Port = 8000
+	cr.Spec.Debug.Suspend = true
+	cr.Spec.Helidon = &verrazzanov1.HelidonSpec{Version: "1.4.4"}
+	assert.Equal(t, "-agentlib:jdwp=transport=dt_socket,server=y,suspend=y,address=8000", DebugOptions(cr))
+
+	cr.Spec.Service.Port = 8000
+	assert.EqualError(t, Validate(cr), "debug port 8000 is already used by the application")
+	cr.Spec.Service.Port = 0
+
+	cr.Spec.Runtime = verrazzanov1.HelidonRuntimeNative
+	assert.False(t, DebugEnabled(cr))
+	assert.EqualError(t, Validate(cr), "remote debugging is not supported by the native runtime")
+	cr.Spec.Debug.Enabled = false
+	assert.NoError(t, Validate(cr))
+}
@@ -0,0 +1,124 @@
+// Copyright (c) 2020, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+// Package helidon holds the conventions of the Helidon flavors and versions, used as the defaults of the
+// resources generated for a HelidonApp.
+package helidon
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	verrazzanov1 "github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1"
+)
+
+// DefaultMajorVersion is the Helidon major version of HelidonApps that don't set spec.helidon.version
+const DefaultMajorVersion = 2
+
+// ConfigMountPath is the directory the configMap of spec.helidon is mounted to, the same for all flavors and
+// versions. The application has to add it as a configuration source.
+const ConfigMountPath = "/conf"
+
+// Defaults are the conventions of a Helidon flavor and major version
+type Defaults struct {
+	// LivenessPath is the path of the liveness health check
+	LivenessPath string
+	// ReadinessPath is the path of the readiness health check
+	ReadinessPath string
+	// MetricsPath is the path of the Prometheus metrics endpoint
+	MetricsPath string
+	// ProfileEnv is the environment variable selecting the configuration profile, empty if the version
+	// doesn't support profiles
+	ProfileEnv string
+}
+
+// defaultsTable holds the defaults by flavor and major version. Major versions above the last one use the
+// defaults of the last one.
+var defaultsTable = []struct {
+	flavor   verrazzanov1.HelidonFlavor
+	major    int
+	defaults Defaults
+}{
+	{verrazzanov1.HelidonFlavorSE, 1, Defaults{"/health/live", "/health/ready", "/metrics", ""}},
+	{verrazzanov1.HelidonFlavorMP, 1, Defaults{"/health/live", "/health/ready", "/metrics", ""}},
+	{verrazzanov1.HelidonFlavorSE, 2, Defaults{"/health/live", "/health/ready", "/metrics", "HELIDON_CONFIG_PROFILE"}},
+	{verrazzanov1.HelidonFlavorMP, 2, Defaults{"/health/live", "/health/ready", "/metrics", ""}},
+	{verrazzanov1.HelidonFlavorSE, 3, Defaults{"/health/live", "/health/ready", "/metrics", "HELIDON_CONFIG_PROFILE"}},
+	{verrazzanov1.HelidonFlavorMP, 3, Defaults{"/health/live", "/health/ready", "/metrics", "MP_CONFIG_PROFILE"}},
+	{verrazzanov1.HelidonFlavorSE, 4, Defaults{"/observe/health/live", "/observe/health/ready", "/observe/metrics", "HELIDON_CONFIG_PROFILE"}},
+	{verrazzanov1.HelidonFlavorMP, 4, Defaults{"/observe/health/live", "/observe/health/ready", "/observe/metrics", "MP_CONFIG_PROFILE"}},
+}
+
+// For returns the defaults of the flavor and version of the HelidonApp. HelidonApps without spec.helidon
+// and invalid versions use the defaults of Helidon SE 2.
+func For(cr *verrazzanov1.HelidonApp) Defaults {
+	flavor, major := verrazzanov1.HelidonFlavorSE, DefaultMajorVersion
+	if spec := cr.Spec.Helidon; spec != nil {
+		if spec.Flavor != "" {
+			flavor = spec.Flavor
+		}
+		if v, err := MajorVersion(spec.Version); err == nil {
+			major = v
+		}
+	}
+	var result *Defaults
+	for i := range defaultsTable {
+		row := &defaultsTable[i]
+		if row.flavor == flavor && row.major <= major {
+			result = &row.defaults
+		}
+	}
+	if result == nil {
+		return defaultsTable[0].defaults
+	}
+	return *result
+}
+
+// MajorVersion returns the major version of a Helidon version like 2.4.2 or 4.0.0-M1, or the default major
+// version when empty
+func MajorVersion(version string) (int, error) {
+	if version == "" {
+		return DefaultMajorVersion, nil
+	}
+	major, err := strconv.Atoi(strings.SplitN(version, ".", 2)[0])
+	if err != nil || major < 1 {
+		return 0, fmt.Errorf("invalid Helidon version %s", version)
+	}
+	return major, nil
+}
+
+// Validate checks the Helidon flavor, version and runtime of the HelidonApp, and that its settings are supported
+func Validate(cr *verrazzanov1.HelidonApp) error {
+	if err := validateRuntime(cr); err != nil {
+		return err
+	}
+	if err := validateDebug(cr); err != nil {
+		return err
+	}
+	if err := validateTracing(cr); err != nil {
+		return err
+	}
+	if err := validateLogging(cr); err != nil {
+		return err
+	}
+	spec := cr.Spec.Helidon
+	if spec == nil {
+		return nil
+	}
+	if spec.Flavor != "" && spec.Flavor != verrazzanov1.HelidonFlavorSE && spec.Flavor != verrazzanov1.HelidonFlavorMP {
+		return fmt.Errorf("invalid Helidon flavor %s, expected SE or MP", spec.Flavor)
+	}
+	major, err := MajorVersion(spec.Version)
+	if err != nil {
+		return err
+	}
+	if spec.ConfigProfile != "" && For(cr).ProfileEnv == "" {
+		flavor := spec.Flavor
+		if flavor == "" {
+			flavor = verrazzanov1.HelidonFlavorSE
+		}
+		return fmt.Errorf("configuration profiles are not supported by Helidon %s %d", flavor, major)
+	}
+	return nil
+}
@@ -0,0 +1,48 @@
+// Copyright (c) 2020, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package helidon
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	verrazzanov1 "github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1"
+)
+
+// Test picking the defaults of the flavor and major version
+func TestFor(t *testing.T) {
+	tests := []struct {
+		spec        *verrazzanov1.HelidonSpec
+		metricsPath string
+		profileEnv  string
+	}{
+		{nil, "/metrics", "HELIDON_CONFIG_PROFILE"},
+		{&verrazzanov1.HelidonSpec{Flavor: verrazzanov1.HelidonFlavorMP}, "/metrics", ""},
+		{&verrazzanov1.HelidonSpec{Flavor: verrazzanov1.HelidonFlavorMP, Version: "3.2.1"}, "/metrics", "MP_CONFIG_PROFILE"},
+		{&verrazzanov1.HelidonSpec{Version: "1.4.4"}, "/metrics", ""},
+		{&verrazzanov1.HelidonSpec{Version: "4.0.0-M1"}, "/observe/metrics", "HELIDON_CONFIG_PROFILE"},
+		{&verrazzanov1.HelidonSpec{Flavor: verrazzanov1.HelidonFlavorMP, Version: "5"}, "/observe/metrics", "MP_CONFIG_PROFILE"},
+	}
+	for _, test := range tests {
+		cr := &verrazzanov1.HelidonApp{}
+		cr.Spec.Helidon = test.spec
+		defaults := For(cr)
+		assert.Equal(t, test.metricsPath, defaults.MetricsPath, "%v", test.spec)
+		assert.Equal(t, test.profileEnv, defaults.ProfileEnv, "%v", test.spec)
+	}
+}
+
+// Test validating the Helidon settings
+func TestValidate(t *testing.T) {
+	cr := &verrazzanov1.HelidonApp{}
+	assert.NoError(t, Validate(cr))
+	cr.Spec.Helidon = &verrazzanov1.HelidonSpec{Flavor: "EE"}
+	assert.Error(t, Validate(cr))
+	cr.Spec.Helidon = &verrazzanov1.HelidonSpec{Version: "x.1"}
+	assert.Error(t, Validate(cr))
+	cr.Spec.Helidon = &verrazzanov1.HelidonSpec{Version: "1", ConfigProfile: "dev"}
+	assert.EqualError(t, Validate(cr), "configuration profiles are not supported by Helidon SE 1")
+	cr.Spec.Helidon = &verrazzanov1.HelidonSpec{Version: "2", ConfigProfile: "dev"}
+	assert.NoError(t, Validate(cr))
+}
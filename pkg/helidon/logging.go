@@ -0,0 +1,146 @@
+// Copyright (c) 2020, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package helidon
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	verrazzanov1 "github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1"
+)
+
+// LoggingMountPath is the directory of the logging configuration in the main container
+const LoggingMountPath = "/logging"
+
+// loggerName matches the package or class names of loggers, which must not break the generated properties
+var loggerName = regexp.MustCompile(`^[A-Za-z_$][A-Za-z0-9_$]*(\.[A-Za-z_$][A-Za-z0-9_$]*)*$`)
+
+// julLevels maps the levels of spec.logging to the java.util.logging levels
+var julLevels = map[verrazzanov1.LogLevel]string{
+	verrazzanov1.LogLevelTrace: "FINEST",
+	verrazzanov1.LogLevelDebug: "FINE",
+	verrazzanov1.LogLevelInfo:  "INFO",
+	verrazzanov1.LogLevelWarn:  "WARNING",
+	verrazzanov1.LogLevelError: "SEVERE",
+	verrazzanov1.LogLevelOff:   "OFF",
+}
+
+// LoggingMonitorInterval is the number of seconds between the checks of Log4j 2 for changes of its configuration
+// file when spec.logging is applied in place
+const LoggingMonitorInterval = 30
+
+// julFormat is the format of java.util.logging.SimpleFormatter
+const julFormat = `%1$tY-%1$tm-%1$tdT%1$tH:%1$tM:%1$tS.%1$tL%1$tz %4$s %3$s: %5$s%6$s%n`
+
+// LoggingFramework returns the logging framework of the HelidonApp, jul when not set
+func LoggingFramework(cr *verrazzanov1.HelidonApp) verrazzanov1.LoggingFramework {
+	if cr.Spec.Logging == nil || cr.Spec.Logging.Framework == "" {
+		return verrazzanov1.LoggingFrameworkJUL
+	}
+	return cr.Spec.Logging.Framework
+}
+
+// LoggingInPlace checks if the logging configuration is reloaded by the application when it changes, which
+// only Log4j 2 does
+func LoggingInPlace(cr *verrazzanov1.HelidonApp) bool {
+	return cr.Spec.Logging != nil && cr.Spec.Logging.InPlace && LoggingFramework(cr) == verrazzanov1.LoggingFrameworkLog4j2
+}
+
+// LoggingFile returns the name of the logging configuration file of the framework
+func LoggingFile(cr *verrazzanov1.HelidonApp) string {
+	if LoggingFramework(cr) == verrazzanov1.LoggingFrameworkLog4j2 {
+		return "log4j2.properties"
+	}
+	return "logging.properties"
+}
+
+// LoggingConfig returns the logging configuration file generated from spec.logging, loggers are sorted by name
+// so the file only changes when the spec does
+func LoggingConfig(cr *verrazzanov1.HelidonApp) string {
+	spec := cr.Spec.Logging
+	level := spec.Level
+	if level == "" {
+		level = verrazzanov1.LogLevelInfo
+	}
+	var names []string
+	for name := range spec.Levels {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var b strings.Builder
+	b.WriteString("# Generated from spec.logging of the HelidonApp, changes are overwritten\n")
+	if LoggingFramework(cr) == verrazzanov1.LoggingFrameworkLog4j2 {
+		b.WriteString("status = warn\n")
+		if LoggingInPlace(cr) {
+			fmt.Fprintf(&b, "monitorInterval = %d\n", LoggingMonitorInterval)
+		}
+		b.WriteString("appender.console.type = Console\n")
+		b.WriteString("appender.console.name = console\n")
+		if spec.Format == verrazzanov1.LogFormatJSON {
+			b.WriteString("appender.console.layout.type = JsonTemplateLayout\n")
+			b.WriteString("appender.console.layout.eventTemplateUri = classpath:EcsLayout.json\n")
+		} else {
+			b.WriteString("appender.console.layout.type = PatternLayout\n")
+			b.WriteString("appender.console.layout.pattern = %d{ISO8601} %-5level %logger: %msg%n%throwable\n")
+		}
+		fmt.Fprintf(&b, "rootLogger.level = %s\n", level)
+		b.WriteString("rootLogger.appenderRef.console.ref = console\n")
+		for i, name := range names {
+			fmt.Fprintf(&b, "logger.l%d.name = %s\n", i, name)
+			fmt.Fprintf(&b, "logger.l%d.level = %s\n", i, spec.Levels[name])
+		}
+		return b.String()
+	}
+
+	b.WriteString("handlers = java.util.logging.ConsoleHandler\n")
+	b.WriteString("java.util.logging.ConsoleHandler.level = ALL\n")
+	b.WriteString("java.util.logging.ConsoleHandler.formatter = java.util.logging.SimpleFormatter\n")
+	fmt.Fprintf(&b, "java.util.logging.SimpleFormatter.format = %s\n", julFormat)
+	fmt.Fprintf(&b, ".level = %s\n", julLevels[level])
+	for _, name := range names {
+		fmt.Fprintf(&b, "%s.level = %s\n", name, julLevels[spec.Levels[name]])
+	}
+	return b.String()
+}
+
+// validateLogging checks the levels, format and framework of spec.logging. java.util.logging reads its
+// configuration file from a system property, which the native runtime can't be given, and never reads it again.
+// It has no JSON formatter either, a SimpleFormatter pattern can't escape the messages and stack traces.
+func validateLogging(cr *verrazzanov1.HelidonApp) error {
+	spec := cr.Spec.Logging
+	if spec == nil {
+		return nil
+	}
+	if _, ok := julLevels[spec.Level]; spec.Level != "" && !ok {
+		return fmt.Errorf("invalid log level %s, expected TRACE, DEBUG, INFO, WARN, ERROR or OFF", spec.Level)
+	}
+	for name, level := range spec.Levels {
+		if !loggerName.MatchString(name) {
+			return fmt.Errorf("invalid logger name %q, expected a package or class name", name)
+		}
+		if _, ok := julLevels[level]; !ok {
+			return fmt.Errorf("invalid log level %s of logger %s, expected TRACE, DEBUG, INFO, WARN, ERROR or OFF", level, name)
+		}
+	}
+	if spec.Format != "" && spec.Format != verrazzanov1.LogFormatPlain && spec.Format != verrazzanov1.LogFormatJSON {
+		return fmt.Errorf("invalid log format %s, expected plain or json", spec.Format)
+	}
+	framework := LoggingFramework(cr)
+	if framework != verrazzanov1.LoggingFrameworkJUL && framework != verrazzanov1.LoggingFrameworkLog4j2 {
+		return fmt.Errorf("invalid logging framework %s, expected jul or log4j2", framework)
+	}
+	if framework == verrazzanov1.LoggingFrameworkJUL && Runtime(cr) == verrazzanov1.HelidonRuntimeNative {
+		return fmt.Errorf("the native runtime only supports the log4j2 logging framework")
+	}
+	if framework == verrazzanov1.LoggingFrameworkJUL && spec.Format == verrazzanov1.LogFormatJSON {
+		return fmt.Errorf("the json log format needs the log4j2 logging framework")
+	}
+	if framework == verrazzanov1.LoggingFrameworkJUL && spec.InPlace {
+		return fmt.Errorf("inPlace needs the log4j2 logging framework, java.util.logging doesn't reload its configuration")
+	}
+	return nil
+}
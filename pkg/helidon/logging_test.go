@@ -0,0 +1,84 @@
+// Copyright (c) 2020, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package helidon
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	verrazzanov1 "github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1"
+)
+
+// Test the java.util.logging configuration
+func TestLoggingConfigJUL(t *testing.T) {
+	cr := &verrazzanov1.HelidonApp{}
+	cr.Spec.Logging = &verrazzanov1.LoggingSpec{
+		Levels: map[string]verrazzanov1.LogLevel{"io.helidon.webserver": verrazzanov1.LogLevelDebug, "com.example": verrazzanov1.LogLevelWarn},
+	}
+	assert.Equal(t, "logging.properties", LoggingFile(cr))
+	assert.Equal(t, `# Generated from spec.logging of the HelidonApp, changes are overwritten
+handlers = java.util.logging.ConsoleHandler
+java.util.logging.ConsoleHandler.level = ALL
+java.util.logging.ConsoleHandler.formatter = java.util.logging.SimpleFormatter
+java.util.logging.SimpleFormatter.format = %1$tY-%1$tm-%1$tdT%1$tH:%1$tM:%1$tS.%1$tL%1$tz %4$s %3$s: %5$s%6$s%n
+.level = INFO
+com.example.level = WARNING
+io.helidon.webserver.level = FINE
+`, LoggingConfig(cr))
+}
+
+// Test the Log4j 2 configuration
+func TestLoggingConfigLog4j2(t *testing.T) {
+	cr := &verrazzanov1.HelidonApp{}
+	cr.Spec.Logging = &verrazzanov1.LoggingSpec{
+		Level:     verrazzanov1.LogLevelWarn,
+		Levels:    map[string]verrazzanov1.LogLevel{"io.helidon": verrazzanov1.LogLevelTrace},
+		Format:    verrazzanov1.LogFormatJSON,
+		Framework: verrazzanov1.LoggingFrameworkLog4j2,
+	}
+	assert.Equal(t, "log4j2.properties", LoggingFile(cr))
+	assert.Equal(t, `# Generated from spec.logging of the HelidonApp, changes are overwritten
+status = warn
+appender.console.type = Console
+appender.console.name = console
+appender.console.layout.type = JsonTemplateLayout
+appender.console.layout.eventTemplateUri = classpath:EcsLayout.json
+rootLogger.level = WARN
+rootLogger.appenderRef.console.ref = console
+logger.l0.name = io.helidon
+logger.l0.level = TRACE
+`, LoggingConfig(cr))
+
+	cr.Spec.Logging.InPlace = true
+	assert.Contains(t, LoggingConfig(cr), "status = warn\nmonitorInterval = 30\n")
+}
+
+// Test the validation of spec.logging
+func TestValidateLogging(t *testing.T) {
+	tests := []struct {
+		spec    verrazzanov1.LoggingSpec
+		runtime verrazzanov1.HelidonRuntime
+		message string
+	}{
+		{verrazzanov1.LoggingSpec{Level: "VERBOSE"}, "", "invalid log level VERBOSE, expected TRACE, DEBUG, INFO, WARN, ERROR or OFF"},
+		{verrazzanov1.LoggingSpec{Levels: map[string]verrazzanov1.LogLevel{"io.helidon\nhandlers": verrazzanov1.LogLevelDebug}}, "", `invalid logger name "io.helidon\nhandlers", expected a package or class name`},
+		{verrazzanov1.LoggingSpec{Levels: map[string]verrazzanov1.LogLevel{"io.helidon": "FINE"}}, "", "invalid log level FINE of logger io.helidon, expected TRACE, DEBUG, INFO, WARN, ERROR or OFF"},
+		{verrazzanov1.LoggingSpec{Format: "xml"}, "", "invalid log format xml, expected plain or json"},
+		{verrazzanov1.LoggingSpec{Framework: "logback"}, "", "invalid logging framework logback, expected jul or log4j2"},
+		{verrazzanov1.LoggingSpec{}, verrazzanov1.HelidonRuntimeNative, "the native runtime only supports the log4j2 logging framework"},
+		{verrazzanov1.LoggingSpec{Format: verrazzanov1.LogFormatJSON}, "", "the json log format needs the log4j2 logging framework"},
+		{verrazzanov1.LoggingSpec{InPlace: true}, "", "inPlace needs the log4j2 logging framework, java.util.logging doesn't reload its configuration"},
+	}
+	for _, test := range tests {
+		cr := &verrazzanov1.HelidonApp{}
+		cr.Spec.Runtime = test.runtime
+		cr.Spec.Logging = &test.spec
+		assert.EqualError(t, Validate(cr), test.message)
+	}
+
+	cr := &verrazzanov1.HelidonApp{}
+	cr.Spec.Runtime = verrazzanov1.HelidonRuntimeNative
+	cr.Spec.Logging = &verrazzanov1.LoggingSpec{Framework: verrazzanov1.LoggingFrameworkLog4j2, Levels: map[string]verrazzanov1.LogLevel{"com.example.Orders$Inner": verrazzanov1.LogLevelDebug}}
+	assert.NoError(t, Validate(cr))
+}
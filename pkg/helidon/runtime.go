@@ -0,0 +1,73 @@
+// Copyright (c) 2020, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package helidon
+
+import (
+	"fmt"
+	"strings"
+
+	verrazzanov1 "github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+// RuntimeDefaults are the defaults of a runtime
+type RuntimeDefaults struct {
+	// MemoryRequest is the memory requested by the main container
+	MemoryRequest resource.Quantity
+	// LivenessInitialDelaySeconds is the delay before the first liveness probe
+	LivenessInitialDelaySeconds int32
+	// ReadinessInitialDelaySeconds is the delay before the first readiness probe
+	ReadinessInitialDelaySeconds int32
+}
+
+// runtimeDefaults holds the defaults by runtime. Native executables start in milliseconds and need a
+// fraction of the memory of a JVM.
+var runtimeDefaults = map[verrazzanov1.HelidonRuntime]RuntimeDefaults{
+	verrazzanov1.HelidonRuntimeJVM:    {resource.MustParse("512Mi"), 30, 10},
+	verrazzanov1.HelidonRuntimeNative: {resource.MustParse("64Mi"), 5, 1},
+}
+
+// JVMOptionsEnv are the environment variables passing options to the JVM, which a native executable ignores
+var JVMOptionsEnv = []string{"JAVA_OPTS", "JAVA_TOOL_OPTIONS", "JDK_JAVA_OPTIONS", "_JAVA_OPTIONS"}
+
+// jmxOptionPrefix starts the system properties enabling the JMX agent, which native executables don't have
+const jmxOptionPrefix = "-Dcom.sun.management"
+
+// Runtime returns the runtime of the HelidonApp, jvm when not set
+func Runtime(cr *verrazzanov1.HelidonApp) verrazzanov1.HelidonRuntime {
+	if cr.Spec.Runtime == "" {
+		return verrazzanov1.HelidonRuntimeJVM
+	}
+	return cr.Spec.Runtime
+}
+
+// ForRuntime returns the defaults of the runtime of the HelidonApp
+func ForRuntime(cr *verrazzanov1.HelidonApp) RuntimeDefaults {
+	if defaults, ok := runtimeDefaults[Runtime(cr)]; ok {
+		return defaults
+	}
+	return runtimeDefaults[verrazzanov1.HelidonRuntimeJVM]
+}
+
+// validateRuntime checks the runtime, and that no JVM setting or JMX option is used with the native runtime
+func validateRuntime(cr *verrazzanov1.HelidonApp) error {
+	runtime := Runtime(cr)
+	if runtime != verrazzanov1.HelidonRuntimeJVM && runtime != verrazzanov1.HelidonRuntimeNative {
+		return fmt.Errorf("invalid runtime %s, expected jvm or native", runtime)
+	}
+	if runtime != verrazzanov1.HelidonRuntimeNative {
+		return nil
+	}
+	for _, env := range cr.Spec.Container.Env {
+		for _, name := range JVMOptionsEnv {
+			if env.Name == name {
+				return fmt.Errorf("environment variable %s sets JVM options, which the native runtime doesn't support", name)
+			}
+		}
+		if strings.Contains(env.Value, jmxOptionPrefix) {
+			return fmt.Errorf("environment variable %s sets JMX options, which the native runtime doesn't support", env.Name)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,37 @@
+// Copyright (c) 2020, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package helidon
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	verrazzanov1 "github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// Test the runtime defaults and that JVM options are rejected for native executables
+func TestRuntime(t *testing.T) {
+	cr := &verrazzanov1.HelidonApp{}
+	assert.Equal(t, verrazzanov1.HelidonRuntimeJVM, Runtime(cr))
+	assert.Equal(t, "512Mi", runtimeMemory(cr))
+	cr.Spec.Container.Env = []corev1.EnvVar{{Name: "JAVA_OPTS", Value: "-Xmx256m"}}
+	assert.NoError(t, Validate(cr))
+
+	cr.Spec.Runtime = verrazzanov1.HelidonRuntimeNative
+	assert.Equal(t, "64Mi", runtimeMemory(cr))
+	assert.EqualError(t, Validate(cr), "environment variable JAVA_OPTS sets JVM options, which the native runtime doesn't support")
+	cr.Spec.Container.Env = []corev1.EnvVar{{Name: "APP_ARGS", Value: "-Dcom.sun.management.jmxremote.port=9010"}}
+	assert.EqualError(t, Validate(cr), "environment variable APP_ARGS sets JMX options, which the native runtime doesn't support")
+	cr.Spec.Container.Env = nil
+	assert.NoError(t, Validate(cr))
+
+	cr.Spec.Runtime = "wasm"
+	assert.Error(t, Validate(cr))
+}
+
+func runtimeMemory(cr *verrazzanov1.HelidonApp) string {
+	memory := ForRuntime(cr).MemoryRequest
+	return memory.String()
+}
@@ -0,0 +1,103 @@
+// Copyright (c) 2020, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package helidon
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	verrazzanov1 "github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1"
+)
+
+// DefaultTracingEndpoint is the operator wide collector endpoint of HelidonApps not setting spec.tracing.endpoint.
+// It must be set before HelidonApps are rendered.
+var DefaultTracingEndpoint string
+
+// TracingEnabled returns true if the HelidonApp sends spans
+func TracingEnabled(cr *verrazzanov1.HelidonApp) bool {
+	return cr.Spec.Tracing != nil && cr.Spec.Tracing.Enabled
+}
+
+// TracingProvider returns the tracing system of the HelidonApp, opentelemetry when not set
+func TracingProvider(cr *verrazzanov1.HelidonApp) verrazzanov1.TracingProvider {
+	if cr.Spec.Tracing == nil || cr.Spec.Tracing.Provider == "" {
+		return verrazzanov1.TracingProviderOpenTelemetry
+	}
+	return cr.Spec.Tracing.Provider
+}
+
+// TracingEndpoint returns the collector endpoint of the HelidonApp, empty when neither the HelidonApp nor the
+// operator set one
+func TracingEndpoint(cr *verrazzanov1.HelidonApp) string {
+	if cr.Spec.Tracing != nil && cr.Spec.Tracing.Endpoint != "" {
+		return cr.Spec.Tracing.Endpoint
+	}
+	return DefaultTracingEndpoint
+}
+
+// TracingServiceName returns the service name of the spans of the HelidonApp, spec.name when not set
+func TracingServiceName(cr *verrazzanov1.HelidonApp) string {
+	if cr.Spec.Tracing != nil && cr.Spec.Tracing.ServiceName != "" {
+		return cr.Spec.Tracing.ServiceName
+	}
+	return cr.Spec.Name
+}
+
+// ConfigEnv returns the environment variable setting a Helidon configuration key. Helidon MP follows the
+// MicroProfile Config mapping, which replaces any character that isn't alphanumeric with an underscore. Helidon SE
+// maps underscores to dots and _dash_ to dashes, and also looks up the lower case key, so keys are upper cased
+// unless they are in camel case.
+func ConfigEnv(cr *verrazzanov1.HelidonApp, key string) string {
+	if spec := cr.Spec.Helidon; spec != nil && spec.Flavor == verrazzanov1.HelidonFlavorMP {
+		return strings.ToUpper(strings.Map(func(r rune) rune {
+			if (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') {
+				return r
+			}
+			return '_'
+		}, key))
+	}
+	if key == strings.ToLower(key) {
+		key = strings.ToUpper(key)
+	}
+	return strings.NewReplacer(".", "_", "-", "_dash_").Replace(key)
+}
+
+// validateTracing checks the provider, endpoint and sampling rate of spec.tracing
+func validateTracing(cr *verrazzanov1.HelidonApp) error {
+	spec := cr.Spec.Tracing
+	if spec == nil {
+		return nil
+	}
+	switch spec.Provider {
+	case "", verrazzanov1.TracingProviderOpenTelemetry, verrazzanov1.TracingProviderJaeger, verrazzanov1.TracingProviderZipkin:
+	default:
+		return fmt.Errorf("invalid tracing provider %s, expected opentelemetry, jaeger or zipkin", spec.Provider)
+	}
+	if spec.Endpoint != "" {
+		endpoint, err := url.Parse(spec.Endpoint)
+		if err != nil || (endpoint.Scheme != "http" && endpoint.Scheme != "https") || endpoint.Hostname() == "" {
+			return fmt.Errorf("invalid tracing endpoint %s, expected an http or https URL", spec.Endpoint)
+		}
+	}
+	if spec.SamplingRate != "" {
+		if _, err := SamplingRate(cr); err != nil {
+			return err
+		}
+		if spec.Provider == verrazzanov1.TracingProviderZipkin {
+			return fmt.Errorf("the zipkin tracing provider doesn't support a sampling rate")
+		}
+	}
+	return nil
+}
+
+// SamplingRate returns the fraction of the traces sampled by the HelidonApp
+func SamplingRate(cr *verrazzanov1.HelidonApp) (float64, error) {
+	rate, err := strconv.ParseFloat(cr.Spec.Tracing.SamplingRate, 64)
+	if err != nil || rate < 0 || rate > 1 {
+		return 0, fmt.Errorf("invalid tracing sampling rate %s, expected a number between 0 and 1", cr.Spec.Tracing.SamplingRate)
+	}
+	return rate, nil
+}
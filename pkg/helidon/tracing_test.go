@@ -0,0 +1,52 @@
+// Copyright (c) 2020, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package helidon
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	verrazzanov1 "github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1"
+)
+
+// Test the environment variables of Helidon configuration keys
+func TestConfigEnv(t *testing.T) {
+	cr := &verrazzanov1.HelidonApp{}
+	assert.Equal(t, "TRACING_SAMPLER_dash_TYPE", ConfigEnv(cr, "tracing.sampler-type"))
+	cr.Spec.Helidon = &verrazzanov1.HelidonSpec{Flavor: verrazzanov1.HelidonFlavorMP}
+	assert.Equal(t, "TRACING_SAMPLER_TYPE", ConfigEnv(cr, "tracing.sampler-type"))
+}
+
+// Test the defaults and the validation of spec.tracing
+func TestTracing(t *testing.T) {
+	cr := &verrazzanov1.HelidonApp{}
+	cr.Spec.Name = "myapp"
+	assert.False(t, TracingEnabled(cr))
+	cr.Spec.Tracing = &verrazzanov1.TracingSpec{Enabled: true}
+	assert.True(t, TracingEnabled(cr))
+	assert.Equal(t, verrazzanov1.TracingProviderOpenTelemetry, TracingProvider(cr))
+	assert.Equal(t, "myapp", TracingServiceName(cr))
+	assert.Equal(t, "", TracingEndpoint(cr))
+
+	DefaultTracingEndpoint = "http://collector:4318"
+	defer func() { DefaultTracingEndpoint = "" }()
+	assert.Equal(t, "http://collector:4318", TracingEndpoint(cr))
+	cr.Spec.Tracing.Endpoint = "http://jaeger:14268/api/traces"
+	assert.Equal(t, "http://jaeger:14268/api/traces", TracingEndpoint(cr))
+	assert.NoError(t, Validate(cr))
+
+	tests := []struct {
+		spec    verrazzanov1.TracingSpec
+		message string
+	}{
+		{verrazzanov1.TracingSpec{Provider: "datadog"}, "invalid tracing provider datadog, expected opentelemetry, jaeger or zipkin"},
+		{verrazzanov1.TracingSpec{Endpoint: "jaeger:14268"}, "invalid tracing endpoint jaeger:14268, expected an http or https URL"},
+		{verrazzanov1.TracingSpec{SamplingRate: "1.5"}, "invalid tracing sampling rate 1.5, expected a number between 0 and 1"},
+		{verrazzanov1.TracingSpec{Provider: verrazzanov1.TracingProviderZipkin, SamplingRate: "0.5"}, "the zipkin tracing provider doesn't support a sampling rate"},
+	}
+	for _, test := range tests {
+		cr.Spec.Tracing = &test.spec
+		assert.EqualError(t, Validate(cr), test.message)
+	}
+}
@@ -0,0 +1,193 @@
+// Copyright (c) 2020, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+// Package imagepolicy checks the container images of HelidonApps against the HelidonAppImagePolicies of the
+// cluster, so that only images from trusted registries, with acceptable tags, are deployed.
+package imagepolicy
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	verrazzanov1 "github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1"
+	"github.com/verrazzano/verrazzano-helidon-app-operator/pkg/dependencies"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// DefaultRegistry is the registry of images without a registry
+	DefaultRegistry = "docker.io"
+	// DefaultTag is the tag of images without a tag or digest
+	DefaultTag = "latest"
+)
+
+// Check returns the violations of the images of the HelidonApp against the policies that apply to it, or nil
+// if the HelidonApp complies. Policies and namespaces are read with the reader.
+func Check(ctx context.Context, reader client.Reader, cr *verrazzanov1.HelidonApp) ([]string, error) {
+	policies := &verrazzanov1.HelidonAppImagePolicyList{}
+	if err := reader.List(ctx, policies); err != nil {
+		return nil, err
+	}
+	if len(policies.Items) == 0 {
+		return nil, nil
+	}
+
+	namespaceLabels, err := getNamespaceLabels(ctx, reader, cr.Namespace, cr.Spec.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	var violations []string
+	for i := range policies.Items {
+		policy := &policies.Items[i]
+		applies, err := appliesTo(policy, namespaceLabels)
+		if err != nil {
+			return nil, fmt.Errorf("invalid namespaceSelector in HelidonAppImagePolicy %s: %s", policy.Name, err.Error())
+		}
+		if !applies {
+			continue
+		}
+		for _, image := range Images(cr) {
+			violations = append(violations, CheckImage(policy, image)...)
+		}
+	}
+	return violations, nil
+}
+
+// Images returns the images run for the HelidonApp, without duplicates: the images of the main, additional and
+// init containers, of the Jobs of spec.hooks and of the init containers waiting for spec.dependsOn
+func Images(cr *verrazzanov1.HelidonApp) []string {
+	images := []string{cr.Spec.Container.Image}
+	for _, c := range cr.Spec.Containers {
+		images = append(images, c.Image)
+	}
+	for _, c := range cr.Spec.InitContainers {
+		images = append(images, c.Image)
+	}
+	// The hooks run the image of the main container unless they set their own
+	if hooks := cr.Spec.Hooks; hooks != nil {
+		for _, hook := range []*verrazzanov1.HookSpec{hooks.PreDeploy, hooks.PostDeploy} {
+			if hook != nil && hook.Image != "" {
+				images = append(images, hook.Image)
+			}
+		}
+	}
+	for _, dependency := range cr.Spec.DependsOn {
+		if dependency.WaitFor {
+			images = append(images, dependencies.WaitForImage)
+			break
+		}
+	}
+
+	seen := make(map[string]bool)
+	result := images[:0]
+	for _, image := range images {
+		if !seen[image] {
+			seen[image] = true
+			result = append(result, image)
+		}
+	}
+	return result
+}
+
+// CheckImage returns the violations of an image against a policy
+func CheckImage(policy *verrazzanov1.HelidonAppImagePolicy, image string) []string {
+	var violations []string
+	repository, tag, digest := ParseImage(image)
+	if len(policy.Spec.AllowedRegistries) > 0 && !allowedRegistry(policy.Spec.AllowedRegistries, repository) {
+		violations = append(violations, fmt.Sprintf("image %s is not from a registry allowed by HelidonAppImagePolicy %s", image, policy.Name))
+	}
+	if tag != "" && contains(policy.Spec.ForbiddenTags, tag) {
+		violations = append(violations, fmt.Sprintf("image %s uses tag %s forbidden by HelidonAppImagePolicy %s", image, tag, policy.Name))
+	}
+	if policy.Spec.RequireDigest && digest == "" {
+		violations = append(violations, fmt.Sprintf("image %s is not referenced by digest as required by HelidonAppImagePolicy %s", image, policy.Name))
+	}
+	return violations
+}
+
+// ParseImage splits an image reference into the repository including the registry, the tag and the digest.
+// The registry defaults to docker.io and the tag to latest when the image has neither a tag nor a digest.
+func ParseImage(image string) (repository string, tag string, digest string) {
+	name := image
+	if i := strings.Index(name, "@"); i >= 0 {
+		name, digest = name[:i], name[i+1:]
+	}
+	if i := strings.LastIndex(name, ":"); i > strings.LastIndex(name, "/") {
+		name, tag = name[:i], name[i+1:]
+	}
+	if tag == "" && digest == "" {
+		tag = DefaultTag
+	}
+
+	// The first component is a registry if it looks like a host name, as docker does it
+	parts := strings.SplitN(name, "/", 2)
+	if len(parts) == 1 {
+		return DefaultRegistry + "/library/" + name, tag, digest
+	}
+	if !strings.ContainsAny(parts[0], ".:") && parts[0] != "localhost" {
+		return DefaultRegistry + "/" + name, tag, digest
+	}
+	return name, tag, digest
+}
+
+// allowedRegistry checks if the repository is one of the allowed registries or repository paths, or below one
+func allowedRegistry(allowed []string, repository string) bool {
+	for _, registry := range allowed {
+		registry = strings.TrimSuffix(registry, "/")
+		if repository == registry || strings.HasPrefix(repository, registry+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+// appliesTo checks if the namespace selector of the policy matches the labels of one of the namespaces
+func appliesTo(policy *verrazzanov1.HelidonAppImagePolicy, namespaceLabels []labels.Set) (bool, error) {
+	if policy.Spec.NamespaceSelector == nil {
+		return true, nil
+	}
+	selector, err := metav1.LabelSelectorAsSelector(policy.Spec.NamespaceSelector)
+	if err != nil {
+		return false, err
+	}
+	for _, l := range namespaceLabels {
+		if selector.Matches(l) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// getNamespaceLabels returns the labels of the namespaces, skipping the namespaces that don't exist yet
+func getNamespaceLabels(ctx context.Context, reader client.Reader, names ...string) ([]labels.Set, error) {
+	var result []labels.Set
+	for _, name := range names {
+		if name == "" {
+			continue
+		}
+		ns := &corev1.Namespace{}
+		err := reader.Get(ctx, types.NamespacedName{Name: name}, ns)
+		if errors.IsNotFound(err) {
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+		result = append(result, labels.Set(ns.Labels))
+	}
+	return result, nil
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
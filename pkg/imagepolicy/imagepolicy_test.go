@@ -0,0 +1,115 @@
+// Copyright (c) 2020, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package imagepolicy
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	verrazzanov1 "github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1"
+	"github.com/verrazzano/verrazzano-helidon-app-operator/pkg/dependencies"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+// Test splitting image references
+func TestParseImage(t *testing.T) {
+	tests := []struct {
+		image      string
+		repository string
+		tag        string
+		digest     string
+	}{
+		{"nginx", "docker.io/library/nginx", "latest", ""},
+		{"myorg/app:1.0", "docker.io/myorg/app", "1.0", ""},
+		{"container-registry.oracle.com/app:1.0", "container-registry.oracle.com/app", "1.0", ""},
+		{"localhost:5000/app", "localhost:5000/app", "latest", ""},
+		{"localhost/app@sha256:abc", "localhost/app", "", "sha256:abc"},
+		{"ghcr.io/myorg/app:1.0@sha256:abc", "ghcr.io/myorg/app", "1.0", "sha256:abc"},
+	}
+	for _, test := range tests {
+		repository, tag, digest := ParseImage(test.image)
+		assert.Equal(t, test.repository, repository, test.image)
+		assert.Equal(t, test.tag, tag, test.image)
+		assert.Equal(t, test.digest, digest, test.image)
+	}
+}
+
+// Test checking images against the rules of a policy
+func TestCheckImage(t *testing.T) {
+	policy := &verrazzanov1.HelidonAppImagePolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "policy"},
+		Spec: verrazzanov1.HelidonAppImagePolicySpec{
+			AllowedRegistries: []string{"container-registry.oracle.com", "ghcr.io/myorg/"},
+			ForbiddenTags:     []string{"latest", "dev"},
+		},
+	}
+	assert.Empty(t, CheckImage(policy, "container-registry.oracle.com/app:1.0"))
+	assert.Empty(t, CheckImage(policy, "ghcr.io/myorg/app:1.0"))
+	assert.Len(t, CheckImage(policy, "ghcr.io/myorganization/app:1.0"), 1)
+	assert.Len(t, CheckImage(policy, "container-registry.oracle.com.evil.io/app:1.0"), 1)
+	assert.Len(t, CheckImage(policy, "container-registry.oracle.com/app"), 1, "Expected untagged image to use latest")
+	assert.Len(t, CheckImage(policy, "nginx:dev"), 2)
+
+	policy.Spec.RequireDigest = true
+	assert.Len(t, CheckImage(policy, "container-registry.oracle.com/app:1.0"), 1)
+	assert.Empty(t, CheckImage(policy, "container-registry.oracle.com/app@sha256:abc"))
+}
+
+// Test the images of the containers, hooks and dependencies of a HelidonApp are checked
+func TestImages(t *testing.T) {
+	app := &verrazzanov1.HelidonApp{}
+	app.Spec.Container.Image = "app:1.0"
+	app.Spec.InitContainers = []corev1.Container{{Name: "init", Image: "init:1.0"}}
+	assert.Equal(t, []string{"app:1.0", "init:1.0"}, Images(app))
+
+	app.Spec.Hooks = &verrazzanov1.HooksSpec{
+		PreDeploy:  &verrazzanov1.HookSpec{Image: "migrations:1.0"},
+		PostDeploy: &verrazzanov1.HookSpec{Command: []string{"smoke-test"}},
+	}
+	app.Spec.DependsOn = []verrazzanov1.Dependency{{Name: "db"}, {Name: "auth", WaitFor: true}, {Name: "cache", WaitFor: true}}
+	assert.Equal(t, []string{"app:1.0", "init:1.0", "migrations:1.0", dependencies.WaitForImage}, Images(app))
+
+	app.Spec.Hooks.PostDeploy.Image = "app:1.0"
+	assert.Equal(t, []string{"app:1.0", "init:1.0", "migrations:1.0", dependencies.WaitForImage}, Images(app))
+}
+
+// Test that only the policies selecting the namespace of the HelidonApp or its target namespace apply
+func TestCheck(t *testing.T) {
+	s := runtime.NewScheme()
+	assert.NoError(t, scheme.AddToScheme(s))
+	assert.NoError(t, verrazzanov1.AddToScheme(s))
+	prod := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "prod", Labels: map[string]string{"env": "prod"}}}
+	dev := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "dev"}}
+	policy := &verrazzanov1.HelidonAppImagePolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "prod"},
+		Spec: verrazzanov1.HelidonAppImagePolicySpec{
+			NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"env": "prod"}},
+			RequireDigest:     true,
+		},
+	}
+	c := fake.NewFakeClientWithScheme(s, prod, dev, policy)
+
+	app := &verrazzanov1.HelidonApp{ObjectMeta: metav1.ObjectMeta{Name: "myapp", Namespace: "dev"}}
+	app.Spec.Namespace = "dev"
+	app.Spec.Container.Image = "app:1.0"
+	app.Spec.InitContainers = []corev1.Container{{Name: "init", Image: "init:1.0"}}
+	violations, err := Check(context.TODO(), c, app)
+	assert.NoError(t, err)
+	assert.Empty(t, violations)
+
+	app.Spec.Namespace = "prod"
+	violations, err = Check(context.TODO(), c, app)
+	assert.NoError(t, err)
+	assert.Len(t, violations, 2, "Expected main and init container images to violate the policy")
+
+	app.Spec.Namespace = "missing"
+	violations, err = Check(context.TODO(), c, app)
+	assert.NoError(t, err)
+	assert.Empty(t, violations)
+}
@@ -0,0 +1,213 @@
+// Copyright (c) 2020, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+// Package importer builds HelidonApps from existing Deployments and Services, the reverse of package render.
+package importer
+
+import (
+	"fmt"
+	"reflect"
+
+	verrazzanov1 "github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1"
+	"github.com/verrazzano/verrazzano-helidon-app-operator/pkg/render"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// AdoptAnnotation asks the operator to take over existing resources with the same name as the HelidonApp
+// resources, instead of failing. It is set on imported HelidonApps.
+const AdoptAnnotation = "helidonapp.verrazzano.io/adopt"
+
+// FindService returns the service exposing the deployment: the service with the same name, or else the
+// first service whose selector matches the pod labels. Returns nil if there is none.
+func FindService(deployment *appsv1.Deployment, services []corev1.Service) *corev1.Service {
+	var matching *corev1.Service
+	for i := range services {
+		svc := &services[i]
+		if svc.Namespace != deployment.Namespace {
+			continue
+		}
+		if svc.Name == deployment.Name {
+			return svc
+		}
+		if matching == nil && len(svc.Spec.Selector) > 0 &&
+			labels.SelectorFromSet(svc.Spec.Selector).Matches(labels.Set(deployment.Spec.Template.Labels)) {
+			matching = svc
+		}
+	}
+	return matching
+}
+
+// FromDeployment returns a HelidonApp equivalent to the deployment and the service, which may be nil. The
+// returned warnings describe the settings that can't be represented in a HelidonApp and would be lost.
+func FromDeployment(deployment *appsv1.Deployment, service *corev1.Service) (*verrazzanov1.HelidonApp, []string) {
+	var warnings []string
+	warn := func(format string, args ...interface{}) {
+		warnings = append(warnings, fmt.Sprintf(format, args...))
+	}
+
+	app := &verrazzanov1.HelidonApp{
+		TypeMeta: metav1.TypeMeta{APIVersion: verrazzanov1.SchemeGroupVersion.String(), Kind: "HelidonApp"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        deployment.Name,
+			Namespace:   deployment.Namespace,
+			Annotations: map[string]string{AdoptAnnotation: "true"},
+		},
+	}
+	spec := &app.Spec
+	spec.Description = fmt.Sprintf("Imported from Deployment %s/%s", deployment.Namespace, deployment.Name)
+	spec.Name = deployment.Name
+	spec.Namespace = deployment.Namespace
+	spec.Scaling.Replicas = deployment.Spec.Replicas
+
+	pod := deployment.Spec.Template.Spec
+	spec.ServiceAccountName = pod.ServiceAccountName
+	spec.Container.ImagePullSecrets = pod.ImagePullSecrets
+	spec.InitContainers = pod.InitContainers
+	spec.Volumes = pod.Volumes
+
+	// The first container is the main container, like in the Deployments generated by the operator
+	if len(pod.Containers) == 0 {
+		warn("Deployment has no containers")
+		return app, warnings
+	}
+	container := pod.Containers[0]
+	spec.Container.Image = container.Image
+	spec.Container.ImagePullPolicy = container.ImagePullPolicy
+	spec.Container.Env = container.Env
+	spec.Containers = pod.Containers[1:]
+	if len(spec.Containers) == 0 {
+		spec.Containers = nil
+	}
+	if container.Name != deployment.Name {
+		warn("main container is named %s, new Deployments name it %s", container.Name, deployment.Name)
+	}
+	warnContainer(warn, &container)
+
+	var containerPort int32
+	if len(container.Ports) > 0 {
+		containerPort = container.Ports[0].ContainerPort
+	}
+	if len(container.Ports) > 1 {
+		warn("main container ports other than %d are not supported", containerPort)
+	}
+
+	if service == nil {
+		warn("no Service found for the Deployment, a Service will be created")
+		spec.Service.Port = containerPort
+	} else {
+		importService(warn, deployment, service, containerPort, spec)
+	}
+
+	importMetrics(warn, deployment, spec)
+	warnPodSpec(warn, &pod)
+	if !reflect.DeepEqual(deployment.Spec.Template.Labels, map[string]string{"app": deployment.Name}) {
+		warn("pod labels %v differ from app=%s used by new Deployments", deployment.Spec.Template.Labels, deployment.Name)
+	}
+	return app, warnings
+}
+
+// importService sets the ports of the HelidonApp from the service
+func importService(warn func(string, ...interface{}), deployment *appsv1.Deployment, service *corev1.Service, containerPort int32, spec *verrazzanov1.HelidonAppSpec) {
+	if service.Name != deployment.Name {
+		warn("Service is named %s, a Service named %s will be created", service.Name, deployment.Name)
+	}
+	if service.Spec.Type != "" && service.Spec.Type != corev1.ServiceTypeClusterIP {
+		warn("Service type %s is not supported, new Services are ClusterIP", service.Spec.Type)
+	}
+	if len(service.Spec.Ports) == 0 {
+		spec.Service.Port = containerPort
+		return
+	}
+	if len(service.Spec.Ports) > 1 {
+		warn("Service ports other than %d are not supported", service.Spec.Ports[0].Port)
+	}
+	port := service.Spec.Ports[0]
+	spec.Service.Port = port.Port
+	if port.TargetPort.IntValue() != 0 && port.TargetPort.IntVal != port.Port {
+		spec.Service.TargetPort = port.TargetPort.IntVal
+	} else if port.TargetPort.IntValue() == 0 && port.TargetPort.StrVal != "" {
+		warn("named Service targetPort %s is not supported", port.TargetPort.StrVal)
+	}
+	if containerPort != 0 && containerPort != spec.Service.Port {
+		warn("main container port %d will be changed to the Service port %d", containerPort, spec.Service.Port)
+	}
+}
+
+// importMetrics sets the metrics scraping of the HelidonApp from the Prometheus annotations of the pods
+func importMetrics(warn func(string, ...interface{}), deployment *appsv1.Deployment, spec *verrazzanov1.HelidonAppSpec) {
+	annotations := deployment.Spec.Template.Annotations
+	if annotations["prometheus.io/scrape"] != "true" {
+		enabled := false
+		spec.Observability.Metrics.Enabled = &enabled
+	} else if path := annotations["prometheus.io/path"]; path != "" && path != "/metrics" {
+		spec.Observability.Metrics.Path = path
+	}
+	for key := range annotations {
+		found := false
+		for _, prometheusKey := range render.PrometheusAnnotationKeys {
+			found = found || key == prometheusKey
+		}
+		if !found {
+			warn("pod annotation %s is not supported", key)
+		}
+	}
+}
+
+// warnContainer reports the settings of the main container that can't be represented
+func warnContainer(warn func(string, ...interface{}), c *corev1.Container) {
+	if len(c.Command) > 0 || len(c.Args) > 0 {
+		warn("main container command and args are not supported")
+	}
+	if c.WorkingDir != "" {
+		warn("main container workingDir is not supported")
+	}
+	if len(c.EnvFrom) > 0 {
+		warn("main container envFrom is not supported")
+	}
+	if len(c.Resources.Limits) > 0 || len(c.Resources.Requests) > 0 {
+		warn("main container resources are not supported")
+	}
+	if len(c.VolumeMounts) > 0 {
+		warn("main container volumeMounts are not supported")
+	}
+	if c.LivenessProbe != nil || c.ReadinessProbe != nil || c.StartupProbe != nil {
+		warn("main container probes are not supported")
+	}
+	if c.Lifecycle != nil {
+		warn("main container lifecycle is not supported")
+	}
+	if c.SecurityContext != nil {
+		warn("main container securityContext is not supported")
+	}
+}
+
+// warnPodSpec reports the settings of the pod that can't be represented
+func warnPodSpec(warn func(string, ...interface{}), pod *corev1.PodSpec) {
+	if len(pod.NodeSelector) > 0 {
+		warn("pod nodeSelector is not supported")
+	}
+	if pod.Affinity != nil {
+		warn("pod affinity is not supported")
+	}
+	if len(pod.Tolerations) > 0 {
+		warn("pod tolerations are not supported")
+	}
+	if len(pod.TopologySpreadConstraints) > 0 {
+		warn("pod topologySpreadConstraints are not supported")
+	}
+	if pod.SecurityContext != nil && !reflect.DeepEqual(*pod.SecurityContext, corev1.PodSecurityContext{}) {
+		warn("pod securityContext is not supported")
+	}
+	if pod.PriorityClassName != "" {
+		warn("pod priorityClassName is not supported")
+	}
+	if len(pod.HostAliases) > 0 {
+		warn("pod hostAliases are not supported")
+	}
+	if pod.HostNetwork {
+		warn("pod hostNetwork is not supported")
+	}
+}
@@ -0,0 +1,118 @@
+// Copyright (c) 2020, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package importer
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	verrazzanov1 "github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1"
+	"github.com/verrazzano/verrazzano-helidon-app-operator/pkg/render"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const testResources = `
+apiVersion: v1
+kind: List
+items:
+- apiVersion: apps/v1
+  kind: Deployment
+  metadata:
+    name: hello
+    namespace: myns
+  spec:
+    selector:
+      matchLabels:
+        app: hello
+    template:
+      metadata:
+        labels:
+          app: hello
+      spec:
+        containers:
+        - name: hello
+          image: hello:1.0
+---
+apiVersion: v1
+kind: Service
+metadata:
+  name: hello
+  namespace: myns
+spec:
+  selector:
+    app: hello
+  ports:
+  - port: 8080
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: ignored
+`
+
+// Test reading Deployments and Services, including from a List
+func TestReadResources(t *testing.T) {
+	deployments, services, err := ReadResources(strings.NewReader(testResources))
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(deployments))
+	assert.Equal(t, "hello", deployments[0].Name)
+	assert.Equal(t, 1, len(services))
+}
+
+// Test that importing the resources rendered for a HelidonApp gives back the same HelidonApp, without warnings
+func TestFromDeploymentRoundTrip(t *testing.T) {
+	var replicas int32 = 2
+	app := &verrazzanov1.HelidonApp{ObjectMeta: metav1.ObjectMeta{Name: "myapp", Namespace: "myns"}}
+	app.Spec.Name = "myapp"
+	app.Spec.Namespace = "myns"
+	app.Spec.ServiceAccountName = "mysa"
+	app.Spec.Container.Image = "myimage"
+	app.Spec.Container.ImagePullSecrets = []corev1.LocalObjectReference{{Name: "secret"}}
+	app.Spec.Container.Env = []corev1.EnvVar{{Name: "key", Value: "value"}}
+	app.Spec.Service.Port = 8010
+	app.Spec.Service.TargetPort = 8011
+	app.Spec.Scaling.Replicas = &replicas
+	app.Spec.Observability.Metrics.Path = "/observe"
+	app.Spec.Containers = []corev1.Container{{Name: "sidecar", Image: "sidecar-image"}}
+	app.Spec.InitContainers = []corev1.Container{{Name: "init", Image: "init-image"}}
+	app.Spec.Volumes = []corev1.Volume{{Name: "volume"}}
+
+	imported, warnings := FromDeployment(render.Deployment(app), render.Service(app))
+	assert.Empty(t, warnings)
+	assert.Equal(t, "true", imported.Annotations[AdoptAnnotation])
+	imported.Spec.Description = ""
+	assert.Equal(t, app.Spec, imported.Spec)
+}
+
+// Test that settings that can't be represented are reported
+func TestFromDeploymentWarnings(t *testing.T) {
+	app := &verrazzanov1.HelidonApp{}
+	app.Spec.Name = "myapp"
+	app.Spec.Namespace = "myns"
+	app.Spec.Container.Image = "myimage"
+	deployment := render.Deployment(app)
+	deployment.Spec.Template.Spec.Containers[0].Resources.Limits = corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("1")}
+	deployment.Spec.Template.Spec.NodeSelector = map[string]string{"disk": "ssd"}
+
+	imported, warnings := FromDeployment(deployment, nil)
+	assert.Equal(t, "myimage", imported.Spec.Container.Image)
+	assert.Equal(t, int32(8080), imported.Spec.Service.Port)
+	assert.Equal(t, 3, len(warnings), "Expected warnings for resources, nodeSelector and the missing Service")
+}
+
+// Test finding the Service of a Deployment
+func TestFindService(t *testing.T) {
+	deployments, services, err := ReadResources(strings.NewReader(testResources))
+	assert.NoError(t, err)
+	assert.Equal(t, "hello", FindService(&deployments[0], services).Name)
+
+	services[0].Name = "other"
+	assert.Equal(t, "other", FindService(&deployments[0], services).Name, "Expected Service selecting the pods")
+
+	services[0].Spec.Selector = map[string]string{"app": "nothello"}
+	assert.Nil(t, FindService(&deployments[0], services))
+}
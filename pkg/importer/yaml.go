@@ -0,0 +1,60 @@
+// Copyright (c) 2020, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package importer
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
+	"k8s.io/client-go/kubernetes/scheme"
+)
+
+// ReadResources reads the Deployments and Services from a stream of YAML or JSON documents, other
+// resources are ignored. A List of resources, as returned by kubectl get -o yaml, is also accepted.
+func ReadResources(r io.Reader) ([]appsv1.Deployment, []corev1.Service, error) {
+	decoder := scheme.Codecs.UniversalDeserializer()
+	reader := utilyaml.NewYAMLReader(bufio.NewReader(r))
+	var deployments []appsv1.Deployment
+	var services []corev1.Service
+	add := func(obj runtime.Object) {
+		switch o := obj.(type) {
+		case *appsv1.Deployment:
+			deployments = append(deployments, *o)
+		case *corev1.Service:
+			services = append(services, *o)
+		}
+	}
+	for {
+		doc, err := reader.Read()
+		if err == io.EOF {
+			return deployments, services, nil
+		} else if err != nil {
+			return nil, nil, err
+		}
+		if len(bytes.TrimSpace(doc)) == 0 {
+			continue
+		}
+		obj, _, err := decoder.Decode(doc, nil, nil)
+		if err != nil {
+			return nil, nil, err
+		}
+		list, ok := obj.(*corev1.List)
+		if !ok {
+			add(obj)
+			continue
+		}
+		for _, item := range list.Items {
+			itemObj, _, err := decoder.Decode(item.Raw, nil, nil)
+			if err != nil {
+				return nil, nil, err
+			}
+			add(itemObj)
+		}
+	}
+}
@@ -0,0 +1,176 @@
+// Copyright (c) 2020, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+// Package namespaces contains helpers for restricting the operator to a set of namespaces, either a fixed list
+// taken from WATCH_NAMESPACE or the namespaces matching a label selector.
+package namespaces
+
+import (
+	"context"
+	"os"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+)
+
+// WatchNamespaceSelectorEnvVar is the environment variable holding the label selector for the namespaces to watch
+const WatchNamespaceSelectorEnvVar = "WATCH_NAMESPACE_SELECTOR"
+
+// ParseWatchNamespaces splits a comma separated WATCH_NAMESPACE value into a list of namespaces.
+// An empty list means all namespaces.
+func ParseWatchNamespaces(watchNamespace string) []string {
+	var namespaces []string
+	seen := make(map[string]bool)
+	for _, ns := range strings.Split(watchNamespace, ",") {
+		ns = strings.TrimSpace(ns)
+		if ns == "" || seen[ns] {
+			continue
+		}
+		seen[ns] = true
+		namespaces = append(namespaces, ns)
+	}
+	return namespaces
+}
+
+// GetWatchNamespaceSelector returns the label selector from WATCH_NAMESPACE_SELECTOR, or nil if it is not set
+func GetWatchNamespaceSelector() (labels.Selector, error) {
+	value := strings.TrimSpace(os.Getenv(WatchNamespaceSelectorEnvVar))
+	if value == "" {
+		return nil, nil
+	}
+	return labels.Parse(value)
+}
+
+// ConfigureManagerOptions sets up the cache and client of the manager to watch the given namespaces.
+// A single namespace uses the standard namespaced cache, several namespaces use a multi-namespace cache.
+// In both cases reads of single objects outside the watched namespaces, including cluster scoped objects, and
+// lists of namespaces that are not watched go directly to the API server rather than failing against the cache.
+func ConfigureManagerOptions(options *manager.Options, namespaces []string) {
+	switch len(namespaces) {
+	case 0:
+		options.Namespace = ""
+		return
+	case 1:
+		options.Namespace = namespaces[0]
+	default:
+		options.NewCache = cache.MultiNamespacedCacheBuilder(namespaces)
+	}
+	options.NewClient = newClientFunc(namespaces)
+}
+
+// newClientFunc returns a manager.NewClientFunc creating a client which reads from the cache for the
+// watched namespaces and from the API server for everything else
+func newClientFunc(namespaces []string) manager.NewClientFunc {
+	return func(cache cache.Cache, config *rest.Config, options client.Options) (client.Client, error) {
+		c, err := client.New(config, options)
+		if err != nil {
+			return nil, err
+		}
+		return &client.DelegatingClient{
+			Reader: &namespacedReader{
+				namespaces: toSet(namespaces),
+				cache: &client.DelegatingReader{
+					CacheReader:  cache,
+					ClientReader: c,
+				},
+				client: c,
+			},
+			Writer:       c,
+			StatusClient: c,
+		}, nil
+	}
+}
+
+// namespacedReader reads from the cache for the watched namespaces and from the API server otherwise
+type namespacedReader struct {
+	namespaces map[string]bool
+	cache      client.Reader
+	client     client.Reader
+}
+
+// Get retrieves an obj for the given object key
+func (r *namespacedReader) Get(ctx context.Context, key client.ObjectKey, obj runtime.Object) error {
+	if r.namespaces[key.Namespace] {
+		return r.cache.Get(ctx, key, obj)
+	}
+	return r.client.Get(ctx, key, obj)
+}
+
+// List retrieves a list of objects for the given namespace and list options. Lists of a watched namespace and
+// lists across all namespaces, which include the lists of cluster scoped objects, come from the cache. The cache
+// of several namespaces returns the cluster scoped objects once per namespace, the duplicates are dropped.
+func (r *namespacedReader) List(ctx context.Context, list runtime.Object, opts ...client.ListOption) error {
+	listOpts := client.ListOptions{}
+	listOpts.ApplyOptions(opts)
+	if r.namespaces[listOpts.Namespace] {
+		return r.cache.List(ctx, list, opts...)
+	}
+	if listOpts.Namespace == "" {
+		if err := r.cache.List(ctx, list, opts...); err != nil {
+			return err
+		}
+		return removeDuplicates(list)
+	}
+	return r.client.List(ctx, list, opts...)
+}
+
+// removeDuplicates removes the items of the list with the same namespace and name as an earlier item
+func removeDuplicates(list runtime.Object) error {
+	items, err := meta.ExtractList(list)
+	if err != nil {
+		return err
+	}
+	seen := make(map[types.NamespacedName]bool)
+	var unique []runtime.Object
+	for _, item := range items {
+		accessor, err := meta.Accessor(item)
+		if err != nil {
+			return err
+		}
+		key := types.NamespacedName{Namespace: accessor.GetNamespace(), Name: accessor.GetName()}
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		unique = append(unique, item)
+	}
+	if len(unique) == len(items) {
+		return nil
+	}
+	return meta.SetList(list, unique)
+}
+
+func toSet(namespaces []string) map[string]bool {
+	set := make(map[string]bool)
+	for _, ns := range namespaces {
+		set[ns] = true
+	}
+	return set
+}
+
+// Filter decides whether a namespace is reconciled based on its labels. It only filters at reconcile time,
+// the cache of the manager keeps watching all namespaces. A Filter without a selector matches all namespaces.
+type Filter struct {
+	Selector labels.Selector
+	Reader   client.Reader
+}
+
+// Matches checks if the namespace with the given name is watched
+func (f *Filter) Matches(ctx context.Context, namespace string) (bool, error) {
+	if f == nil || f.Selector == nil {
+		return true, nil
+	}
+	ns := &corev1.Namespace{}
+	if err := f.Reader.Get(ctx, types.NamespacedName{Name: namespace}, ns); err != nil {
+		return false, client.IgnoreNotFound(err)
+	}
+	return f.Selector.Matches(labels.Set(ns.Labels)), nil
+}
@@ -0,0 +1,136 @@
+// Copyright (c) 2020, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package namespaces
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+)
+
+func TestParseWatchNamespaces(t *testing.T) {
+	assert.Nil(t, ParseWatchNamespaces(""), "Expected all namespaces")
+	assert.Equal(t, []string{"ns1"}, ParseWatchNamespaces("ns1"))
+	assert.Equal(t, []string{"ns1", "ns2"}, ParseWatchNamespaces(" ns1, ns2,,ns1 "))
+}
+
+func TestGetWatchNamespaceSelector(t *testing.T) {
+	defer os.Unsetenv(WatchNamespaceSelectorEnvVar)
+
+	os.Unsetenv(WatchNamespaceSelectorEnvVar)
+	selector, err := GetWatchNamespaceSelector()
+	assert.NoError(t, err)
+	assert.Nil(t, selector, "Expected no selector")
+
+	os.Setenv(WatchNamespaceSelectorEnvVar, "tenant=acme")
+	selector, err = GetWatchNamespaceSelector()
+	assert.NoError(t, err)
+	assert.True(t, selector.Matches(labels.Set{"tenant": "acme"}))
+	assert.False(t, selector.Matches(labels.Set{"tenant": "other"}))
+
+	os.Setenv(WatchNamespaceSelectorEnvVar, "tenant in (")
+	_, err = GetWatchNamespaceSelector()
+	assert.Error(t, err, "Expected invalid selector to fail")
+}
+
+func TestConfigureManagerOptions(t *testing.T) {
+	options := manager.Options{}
+	ConfigureManagerOptions(&options, nil)
+	assert.Equal(t, "", options.Namespace)
+	assert.Nil(t, options.NewCache)
+	assert.Nil(t, options.NewClient)
+
+	options = manager.Options{}
+	ConfigureManagerOptions(&options, []string{"ns1"})
+	assert.Equal(t, "ns1", options.Namespace)
+	assert.Nil(t, options.NewCache)
+	assert.NotNil(t, options.NewClient)
+
+	options = manager.Options{}
+	ConfigureManagerOptions(&options, []string{"ns1", "ns2"})
+	assert.Equal(t, "", options.Namespace)
+	assert.NotNil(t, options.NewCache)
+	assert.NotNil(t, options.NewClient)
+}
+
+// Test that reads outside the watched namespaces bypass the cache
+func TestNamespacedReader(t *testing.T) {
+	cached := fake.NewFakeClient(&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "cm", Namespace: "ns1"}},
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "ns1"}}, &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "other"}})
+	direct := fake.NewFakeClient(&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "cm", Namespace: "other"}},
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "other"}})
+	r := &namespacedReader{namespaces: toSet([]string{"ns1"}), cache: cached, client: direct}
+
+	assert.NoError(t, r.Get(context.TODO(), types.NamespacedName{Namespace: "ns1", Name: "cm"}, &corev1.ConfigMap{}))
+	assert.NoError(t, r.Get(context.TODO(), types.NamespacedName{Namespace: "other", Name: "cm"}, &corev1.ConfigMap{}))
+	assert.NoError(t, r.Get(context.TODO(), types.NamespacedName{Name: "other"}, &corev1.Namespace{}))
+
+	list := &corev1.ConfigMapList{}
+	assert.NoError(t, r.List(context.TODO(), list, client.InNamespace("ns1")))
+	assert.Len(t, list.Items, 1)
+	assert.Equal(t, "ns1", list.Items[0].Namespace)
+	assert.NoError(t, r.List(context.TODO(), list, client.InNamespace("other")))
+	assert.Len(t, list.Items, 1)
+	assert.Equal(t, "other", list.Items[0].Namespace)
+
+	// Lists across all namespaces and of cluster scoped objects
+	assert.NoError(t, r.List(context.TODO(), list))
+	assert.Len(t, list.Items, 1)
+	assert.Equal(t, "ns1", list.Items[0].Namespace)
+	namespaceList := &corev1.NamespaceList{}
+	assert.NoError(t, r.List(context.TODO(), namespaceList))
+	assert.Len(t, namespaceList.Items, 2)
+}
+
+func TestRemoveDuplicates(t *testing.T) {
+	// The cache of several namespaces returns each cluster scoped object once per namespace
+	list := &corev1.NamespaceList{Items: []corev1.Namespace{
+		{ObjectMeta: metav1.ObjectMeta{Name: "ns1"}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "ns2"}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "ns1"}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "ns2"}},
+	}}
+	assert.NoError(t, removeDuplicates(list))
+	assert.Len(t, list.Items, 2)
+	assert.Equal(t, "ns1", list.Items[0].Name)
+	assert.Equal(t, "ns2", list.Items[1].Name)
+
+	configMaps := &corev1.ConfigMapList{Items: []corev1.ConfigMap{
+		{ObjectMeta: metav1.ObjectMeta{Name: "cm", Namespace: "ns1"}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "cm", Namespace: "ns2"}},
+	}}
+	assert.NoError(t, removeDuplicates(configMaps))
+	assert.Len(t, configMaps.Items, 2)
+}
+
+func TestFilterMatches(t *testing.T) {
+	c := fake.NewFakeClient(
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "acme", Labels: map[string]string{"tenant": "acme"}}},
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "other"}})
+
+	var nilFilter *Filter
+	matches, err := nilFilter.Matches(context.TODO(), "other")
+	assert.NoError(t, err)
+	assert.True(t, matches, "Expected nil filter to match all namespaces")
+
+	f := &Filter{Selector: labels.SelectorFromSet(labels.Set{"tenant": "acme"}), Reader: c}
+	matches, err = f.Matches(context.TODO(), "acme")
+	assert.NoError(t, err)
+	assert.True(t, matches, "Expected labeled namespace to match")
+	matches, err = f.Matches(context.TODO(), "other")
+	assert.NoError(t, err)
+	assert.False(t, matches, "Expected unlabeled namespace not to match")
+	matches, err = f.Matches(context.TODO(), "missing")
+	assert.NoError(t, err)
+	assert.False(t, matches, "Expected missing namespace not to match")
+}
@@ -0,0 +1,66 @@
+// Copyright (c) 2020, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package render
+
+import (
+	verrazzanov1 "github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1"
+	"github.com/verrazzano/verrazzano-helidon-app-operator/pkg/bindings"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// BindingVolumeName returns the name of the volume of a binding
+func BindingVolumeName(binding verrazzanov1.ServiceBinding) string {
+	return "binding-" + binding.Name
+}
+
+// projectedBindings returns the bindings whose Secret is known, bindings to provisioned services are only
+// projected once the operator resolved their Secret
+func projectedBindings(cr *verrazzanov1.HelidonApp) []verrazzanov1.ServiceBinding {
+	var result []verrazzanov1.ServiceBinding
+	for _, binding := range cr.Spec.Bindings {
+		if bindings.SecretName(cr, binding) != "" {
+			result = append(result, binding)
+		}
+	}
+	return result
+}
+
+// BindingsEnv returns the environment variables of spec.bindings: the root of the bindings, followed by the
+// well-known keys mapped to configuration
+func BindingsEnv(cr *verrazzanov1.HelidonApp) []corev1.EnvVar {
+	projected := projectedBindings(cr)
+	if len(projected) == 0 {
+		return nil
+	}
+	env := []corev1.EnvVar{{Name: bindings.RootEnv, Value: bindings.Root}}
+	for _, binding := range projected {
+		env = append(env, bindings.Env(cr, binding)...)
+	}
+	return env
+}
+
+// bindingVolumeMounts returns the read only mounts of the bindings, under the root of the bindings
+func bindingVolumeMounts(cr *verrazzanov1.HelidonApp) []corev1.VolumeMount {
+	var mounts []corev1.VolumeMount
+	for _, binding := range projectedBindings(cr) {
+		mounts = append(mounts, corev1.VolumeMount{Name: BindingVolumeName(binding), MountPath: bindings.MountPath(binding), ReadOnly: true})
+	}
+	return mounts
+}
+
+// bindingVolumes returns the Secret volumes of the bindings
+func bindingVolumes(cr *verrazzanov1.HelidonApp) []corev1.Volume {
+	var volumes []corev1.Volume
+	for _, binding := range projectedBindings(cr) {
+		defaultMode := corev1.SecretVolumeSourceDefaultMode
+		volumes = append(volumes, corev1.Volume{
+			Name: BindingVolumeName(binding),
+			VolumeSource: corev1.VolumeSource{Secret: &corev1.SecretVolumeSource{
+				SecretName:  bindings.SecretName(cr, binding),
+				DefaultMode: &defaultMode,
+			}},
+		})
+	}
+	return volumes
+}
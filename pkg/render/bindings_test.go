@@ -0,0 +1,44 @@
+// Copyright (c) 2020, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package render
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	verrazzanov1 "github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// Test the projection of bindings, bindings to provisioned services are left out until their Secret is known
+func TestDeploymentBindings(t *testing.T) {
+	app := &verrazzanov1.HelidonApp{}
+	app.Spec.Name = "myapp"
+	app.Spec.Namespace = "myns"
+	app.Spec.Bindings = []verrazzanov1.ServiceBinding{
+		{Name: "cache", Secret: "coherence"},
+		{Name: "db", Service: &verrazzanov1.BindingServiceReference{APIVersion: "example.com/v1", Kind: "Database", Name: "orders"}},
+	}
+
+	main := Deployment(app).Spec.Template.Spec.Containers[0]
+	assert.Equal(t, []corev1.EnvVar{{Name: "SERVICE_BINDING_ROOT", Value: "/bindings"}}, main.Env)
+	assert.Equal(t, []corev1.VolumeMount{{Name: "binding-cache", MountPath: "/bindings/cache", ReadOnly: true}}, main.VolumeMounts)
+
+	app.Status.Bindings = []verrazzanov1.BindingStatus{{Name: "db", Secret: "orders-binding"}}
+	deployment := Deployment(app)
+	main = deployment.Spec.Template.Spec.Containers[0]
+	assert.Equal(t, []corev1.VolumeMount{
+		{Name: "binding-cache", MountPath: "/bindings/cache", ReadOnly: true},
+		{Name: "binding-db", MountPath: "/bindings/db", ReadOnly: true},
+	}, main.VolumeMounts)
+	volumes := deployment.Spec.Template.Spec.Volumes
+	if assert.Len(t, volumes, 2) {
+		assert.Equal(t, "coherence", volumes[0].Secret.SecretName)
+		assert.Equal(t, "orders-binding", volumes[1].Secret.SecretName)
+	}
+
+	app.Spec.Bindings = nil
+	assert.Empty(t, BindingsEnv(app))
+	assert.Empty(t, Volumes(app))
+}
@@ -0,0 +1,54 @@
+// Copyright (c) 2020, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package render
+
+import (
+	"fmt"
+	"net"
+
+	verrazzanov1 "github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1"
+	"github.com/verrazzano/verrazzano-helidon-app-operator/pkg/dependencies"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// ServiceAddress returns the host and port of the Service of the Helidon application inside the cluster
+func ServiceAddress(cr *verrazzanov1.HelidonApp) string {
+	port, _ := Ports(cr)
+	return net.JoinHostPort(fmt.Sprintf("%s.%s.svc", cr.Spec.Name, cr.Spec.Namespace), fmt.Sprint(port))
+}
+
+// InitContainers returns the init containers waiting for the spec.dependsOn with waitFor, followed by the
+// spec.initContainers. Dependencies are only waited for once the operator recorded the address of their Service.
+func InitContainers(cr *verrazzanov1.HelidonApp) []corev1.Container {
+	var containers []corev1.Container
+	for _, dependency := range cr.Spec.DependsOn {
+		if !dependency.WaitFor {
+			continue
+		}
+		key := dependencies.Key(cr, dependency)
+		for _, status := range cr.Status.Dependencies {
+			if status.Namespace != key.Namespace || status.Name != key.Name || status.Address == "" {
+				continue
+			}
+			host, port, err := net.SplitHostPort(status.Address)
+			if err != nil {
+				continue
+			}
+			// The fields defaulted by Kubernetes are set, so the container compares equal to the existing one
+			containers = append(containers, corev1.Container{
+				Name:  dependencies.WaitForContainerPrefix + dependency.Name,
+				Image: dependencies.WaitForImage,
+				Command: []string{"sh", "-c",
+					fmt.Sprintf("until nc -z -w 2 %s %s; do echo waiting for %s; sleep 2; done", host, port, key)},
+				ImagePullPolicy:          corev1.PullIfNotPresent,
+				TerminationMessagePath:   corev1.TerminationMessagePathDefault,
+				TerminationMessagePolicy: corev1.TerminationMessageReadFile,
+			})
+		}
+	}
+	if len(containers) == 0 {
+		return cr.Spec.InitContainers
+	}
+	return append(containers, cr.Spec.InitContainers...)
+}
@@ -0,0 +1,47 @@
+// Copyright (c) 2020, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package render
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	verrazzanov1 "github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Test the init containers waiting for dependencies run before the spec.initContainers, once the address of
+// their Service is known
+func TestInitContainersWaitFor(t *testing.T) {
+	app := &verrazzanov1.HelidonApp{ObjectMeta: metav1.ObjectMeta{Name: "orders", Namespace: "apps"}}
+	app.Spec.Name = "orders"
+	app.Spec.Namespace = "orders-ns"
+	app.Spec.InitContainers = []corev1.Container{{Name: "migrate", Image: "flyway"}}
+	app.Spec.DependsOn = []verrazzanov1.Dependency{{Name: "catalog", WaitFor: true}, {Name: "payments"}}
+	assert.Equal(t, app.Spec.InitContainers, Deployment(app).Spec.Template.Spec.InitContainers)
+
+	app.Status.Dependencies = []verrazzanov1.DependencyStatus{
+		{Name: "catalog", Namespace: "apps", Address: "catalog.catalog-ns.svc:8080"},
+		{Name: "payments", Namespace: "apps", Address: "payments.apps.svc:8080"},
+	}
+	containers := Deployment(app).Spec.Template.Spec.InitContainers
+	if assert.Len(t, containers, 2) {
+		assert.Equal(t, "wait-for-catalog", containers[0].Name)
+		assert.Equal(t, "busybox:1.36", containers[0].Image)
+		assert.Equal(t, []string{"sh", "-c",
+			"until nc -z -w 2 catalog.catalog-ns.svc 8080; do echo waiting for apps/catalog; sleep 2; done"}, containers[0].Command)
+		assert.Equal(t, "migrate", containers[1].Name)
+	}
+}
+
+// Test the address of the Service of a HelidonApp
+func TestServiceAddress(t *testing.T) {
+	app := &verrazzanov1.HelidonApp{}
+	app.Spec.Name = "catalog"
+	app.Spec.Namespace = "catalog-ns"
+	assert.Equal(t, "catalog.catalog-ns.svc:8080", ServiceAddress(app))
+	app.Spec.Service.Port = 80
+	assert.Equal(t, "catalog.catalog-ns.svc:80", ServiceAddress(app))
+}
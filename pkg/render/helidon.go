@@ -0,0 +1,236 @@
+// Copyright (c) 2020, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package render
+
+import (
+	"strings"
+
+	verrazzanov1 "github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1"
+	"github.com/verrazzano/verrazzano-helidon-app-operator/pkg/database"
+	"github.com/verrazzano/verrazzano-helidon-app-operator/pkg/helidon"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+const (
+	// ConfigVolumeName is the name of the volume of the spec.helidon.configMap
+	ConfigVolumeName = "helidon-config"
+	// WalletVolumeName is the name of the volume of spec.database.wallet
+	WalletVolumeName = "database-wallet"
+	// RuntimeLabel is the pod label holding spec.runtime
+	RuntimeLabel = "helidonapp.verrazzano.io/runtime"
+	// DebugPortName is the name of the container port of the JDWP agent
+	DebugPortName = "jdwp"
+)
+
+// SetRuntimeLabel labels the pods of the deployment with spec.runtime when set. The pod labels are copied
+// first, since new deployments share them with the deployment labels and the selector, which must not hold
+// the runtime.
+func SetRuntimeLabel(cr *verrazzanov1.HelidonApp, deployment *appsv1.Deployment) {
+	if cr.Spec.Runtime == "" {
+		return
+	}
+	labels := make(map[string]string)
+	for k, v := range deployment.Spec.Template.Labels {
+		labels[k] = v
+	}
+	labels[RuntimeLabel] = string(cr.Spec.Runtime)
+	deployment.Spec.Template.Labels = labels
+}
+
+// MainResources returns the compute resources of the main container, spec.container.resources or the memory
+// request of spec.runtime when set
+func MainResources(cr *verrazzanov1.HelidonApp) corev1.ResourceRequirements {
+	if cr.Spec.Container.Resources != nil {
+		return *cr.Spec.Container.Resources.DeepCopy()
+	}
+	if cr.Spec.Runtime == "" {
+		return corev1.ResourceRequirements{}
+	}
+	return corev1.ResourceRequirements{
+		Requests: corev1.ResourceList{corev1.ResourceMemory: helidon.ForRuntime(cr).MemoryRequest},
+	}
+}
+
+// MainEnv returns the environment variables of the main container, spec.container.env followed by the
+// variables generated by the operator. Variables set in spec.container.env are not overridden.
+func MainEnv(cr *verrazzanov1.HelidonApp) []corev1.EnvVar {
+	env := append([]corev1.EnvVar{}, cr.Spec.Container.Env...)
+	add := func(name string, value string) {
+		if !hasEnv(env, name) {
+			env = append(env, corev1.EnvVar{Name: name, Value: value})
+		}
+	}
+
+	if spec := cr.Spec.Helidon; spec != nil && spec.ConfigProfile != "" {
+		if name := helidon.For(cr).ProfileEnv; name != "" {
+			add(name, spec.ConfigProfile)
+		}
+	}
+
+	for _, e := range TracingEnv(cr) {
+		add(e.Name, e.Value)
+	}
+	for _, e := range BindingsEnv(cr) {
+		if !hasEnv(env, e.Name) {
+			env = append(env, e)
+		}
+	}
+
+	if database.Wallet(cr) != nil {
+		add(database.TNSAdminEnv, database.WalletMountPath(cr))
+	}
+	if cr.Spec.Logging != nil && helidon.LoggingFramework(cr) == verrazzanov1.LoggingFrameworkLog4j2 {
+		add("LOG4J_CONFIGURATION_FILE", loggingFilePath(cr))
+	}
+
+	// The JVM options of the operator are appended to the ones set in spec.container.env
+	if options := jvmOptions(cr); len(options) > 0 {
+		joined := strings.Join(options, " ")
+		found := false
+		for i := range env {
+			if env[i].Name == helidon.ToolOptionsEnv {
+				found = true
+				if env[i].ValueFrom == nil {
+					env[i].Value = strings.TrimSpace(env[i].Value + " " + joined)
+				}
+			}
+		}
+		if !found {
+			add(helidon.ToolOptionsEnv, joined)
+		}
+	}
+
+	if len(env) == 0 {
+		return nil
+	}
+	return env
+}
+
+// hasEnv checks if env sets the variable
+func hasEnv(env []corev1.EnvVar, name string) bool {
+	for _, e := range env {
+		if e.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// jvmOptions returns the JVM options generated from spec.logging, spec.database and spec.debug. Native
+// executables only get the wallet location from TNS_ADMIN.
+func jvmOptions(cr *verrazzanov1.HelidonApp) []string {
+	var options []string
+	if cr.Spec.Logging != nil && helidon.LoggingFramework(cr) == verrazzanov1.LoggingFrameworkJUL {
+		options = append(options, "-Djava.util.logging.config.file="+loggingFilePath(cr))
+	}
+	if database.Wallet(cr) != nil && helidon.Runtime(cr) == verrazzanov1.HelidonRuntimeJVM {
+		options = append(options, database.WalletJVMOptions(cr)...)
+	}
+	if helidon.DebugEnabled(cr) {
+		options = append(options, helidon.DebugOptions(cr))
+	}
+	return options
+}
+
+// MainPorts returns the ports of the main container, the application port followed by the port of the JDWP
+// agent while debugging. The debug port is not exposed by the Service.
+func MainPorts(cr *verrazzanov1.HelidonApp) []corev1.ContainerPort {
+	port, _ := Ports(cr)
+	ports := []corev1.ContainerPort{{ContainerPort: port}}
+	if helidon.DebugEnabled(cr) {
+		ports = append(ports, corev1.ContainerPort{Name: DebugPortName, ContainerPort: helidon.DebugPort(cr), Protocol: corev1.ProtocolTCP})
+	}
+	return ports
+}
+
+// MainVolumeMounts returns the volume mounts of the main container
+func MainVolumeMounts(cr *verrazzanov1.HelidonApp) []corev1.VolumeMount {
+	var mounts []corev1.VolumeMount
+	if spec := cr.Spec.Helidon; spec != nil && spec.ConfigMap != "" {
+		mounts = append(mounts, corev1.VolumeMount{Name: ConfigVolumeName, MountPath: helidon.ConfigMountPath, ReadOnly: true})
+	}
+	if cr.Spec.Logging != nil {
+		mounts = append(mounts, corev1.VolumeMount{Name: LoggingVolumeName, MountPath: helidon.LoggingMountPath, ReadOnly: true})
+	}
+	if database.Wallet(cr) != nil {
+		mounts = append(mounts, corev1.VolumeMount{Name: WalletVolumeName, MountPath: database.WalletMountPath(cr), ReadOnly: true})
+	}
+	return append(mounts, bindingVolumeMounts(cr)...)
+}
+
+// Volumes returns the volumes of the pod, spec.volumes followed by the volumes generated by the operator
+func Volumes(cr *verrazzanov1.HelidonApp) []corev1.Volume {
+	volumes := append([]corev1.Volume{}, cr.Spec.Volumes...)
+	if spec := cr.Spec.Helidon; spec != nil && spec.ConfigMap != "" {
+		defaultMode := corev1.ConfigMapVolumeSourceDefaultMode
+		volumes = append(volumes, corev1.Volume{
+			Name: ConfigVolumeName,
+			VolumeSource: corev1.VolumeSource{ConfigMap: &corev1.ConfigMapVolumeSource{
+				LocalObjectReference: corev1.LocalObjectReference{Name: spec.ConfigMap},
+				DefaultMode:          &defaultMode,
+			}},
+		})
+	}
+	if cr.Spec.Logging != nil {
+		defaultMode := corev1.ConfigMapVolumeSourceDefaultMode
+		volumes = append(volumes, corev1.Volume{
+			Name: LoggingVolumeName,
+			VolumeSource: corev1.VolumeSource{ConfigMap: &corev1.ConfigMapVolumeSource{
+				LocalObjectReference: corev1.LocalObjectReference{Name: LoggingConfigMapName(cr.Spec.Name)},
+				DefaultMode:          &defaultMode,
+			}},
+		})
+	}
+	if wallet := database.Wallet(cr); wallet != nil {
+		defaultMode := corev1.SecretVolumeSourceDefaultMode
+		volumes = append(volumes, corev1.Volume{
+			Name: WalletVolumeName,
+			VolumeSource: corev1.VolumeSource{Secret: &corev1.SecretVolumeSource{
+				SecretName:  wallet.Secret,
+				DefaultMode: &defaultMode,
+			}},
+		})
+	}
+	volumes = append(volumes, bindingVolumes(cr)...)
+	if len(volumes) == 0 {
+		return nil
+	}
+	return volumes
+}
+
+// Probes returns the liveness and readiness probes of the main container, using the health check paths of the
+// Helidon version and the initial delays of the runtime. There are no probes when spec.helidon is not set, and
+// no liveness probe while debugging so that a JVM stopped at a breakpoint isn't restarted.
+func Probes(cr *verrazzanov1.HelidonApp) (*corev1.Probe, *corev1.Probe) {
+	if cr.Spec.Helidon == nil {
+		return nil, nil
+	}
+	defaults := helidon.For(cr)
+	runtimeDefaults := helidon.ForRuntime(cr)
+	port, _ := Ports(cr)
+	readinessProbe := httpProbe(defaults.ReadinessPath, port, runtimeDefaults.ReadinessInitialDelaySeconds, 5)
+	if helidon.DebugEnabled(cr) {
+		return nil, readinessProbe
+	}
+	return httpProbe(defaults.LivenessPath, port, runtimeDefaults.LivenessInitialDelaySeconds, 10), readinessProbe
+}
+
+// httpProbe returns an HTTP probe, with all the fields defaulted by Kubernetes set so that the probe of an
+// existing Deployment compares equal
+func httpProbe(path string, port int32, initialDelaySeconds int32, periodSeconds int32) *corev1.Probe {
+	return &corev1.Probe{
+		Handler: corev1.Handler{HTTPGet: &corev1.HTTPGetAction{
+			Path:   path,
+			Port:   intstr.FromInt(int(port)),
+			Scheme: corev1.URISchemeHTTP,
+		}},
+		InitialDelaySeconds: initialDelaySeconds,
+		PeriodSeconds:       periodSeconds,
+		TimeoutSeconds:      1,
+		SuccessThreshold:    1,
+		FailureThreshold:    3,
+	}
+}
@@ -0,0 +1,137 @@
+// Copyright (c) 2020, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package render
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	verrazzanov1 "github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Test that the Helidon flavor and version drive the probes, metrics path, profile and config mount
+func TestDeploymentHelidonDefaults(t *testing.T) {
+	app := &verrazzanov1.HelidonApp{ObjectMeta: metav1.ObjectMeta{Name: "myapp", Namespace: "myns"}}
+	app.Spec.Name = "myapp"
+	app.Spec.Namespace = "myns"
+	app.Spec.Container.Image = "myimage"
+
+	main := Deployment(app).Spec.Template.Spec.Containers[0]
+	assert.Nil(t, main.LivenessProbe, "Expected no probes without spec.helidon")
+	assert.Nil(t, main.Env)
+	assert.Equal(t, "/metrics", PrometheusAnnotations(app)["prometheus.io/path"])
+
+	app.Spec.Helidon = &verrazzanov1.HelidonSpec{Flavor: verrazzanov1.HelidonFlavorMP, Version: "4.0.1", ConfigProfile: "prod", ConfigMap: "myconfig"}
+	app.Spec.Container.Env = []corev1.EnvVar{{Name: "JAVA_OPTS", Value: "-Xmx256m"}}
+	deploy := Deployment(app)
+	main = deploy.Spec.Template.Spec.Containers[0]
+	if assert.NotNil(t, main.LivenessProbe) && assert.NotNil(t, main.ReadinessProbe) {
+		assert.Equal(t, "/observe/health/live", main.LivenessProbe.HTTPGet.Path)
+		assert.Equal(t, "/observe/health/ready", main.ReadinessProbe.HTTPGet.Path)
+		assert.Equal(t, 8080, main.ReadinessProbe.HTTPGet.Port.IntValue())
+	}
+	assert.Equal(t, "/observe/metrics", deploy.Spec.Template.Annotations["prometheus.io/path"])
+	assert.Equal(t, []corev1.EnvVar{{Name: "JAVA_OPTS", Value: "-Xmx256m"}, {Name: "MP_CONFIG_PROFILE", Value: "prod"}}, main.Env)
+	assert.Equal(t, []corev1.VolumeMount{{Name: ConfigVolumeName, MountPath: "/conf", ReadOnly: true}}, main.VolumeMounts)
+	if assert.Len(t, deploy.Spec.Template.Spec.Volumes, 1) {
+		assert.Equal(t, "myconfig", deploy.Spec.Template.Spec.Volumes[0].ConfigMap.Name)
+	}
+
+	// The profile set in spec.container.env wins
+	app.Spec.Container.Env = []corev1.EnvVar{{Name: "MP_CONFIG_PROFILE", Value: "dev"}}
+	assert.Equal(t, app.Spec.Container.Env, MainEnv(app))
+}
+
+// Test the defaults of the native runtime and the runtime pod label
+func TestDeploymentNativeRuntime(t *testing.T) {
+	app := &verrazzanov1.HelidonApp{ObjectMeta: metav1.ObjectMeta{Name: "myapp", Namespace: "myns"}}
+	app.Spec.Name = "myapp"
+	app.Spec.Namespace = "myns"
+	app.Spec.Container.Image = "myimage"
+	app.Spec.Helidon = &verrazzanov1.HelidonSpec{}
+	app.Spec.Runtime = verrazzanov1.HelidonRuntimeNative
+
+	deploy := Manifests(app)[1].(*appsv1.Deployment)
+	main := deploy.Spec.Template.Spec.Containers[0]
+	assert.Equal(t, "64Mi", main.Resources.Requests.Memory().String())
+	assert.Equal(t, int32(5), main.LivenessProbe.InitialDelaySeconds)
+	assert.Equal(t, int32(1), main.ReadinessProbe.InitialDelaySeconds)
+	assert.Equal(t, "native", deploy.Spec.Template.Labels[RuntimeLabel])
+	assert.Equal(t, "myapp", deploy.Spec.Template.Labels[OwnerNameLabel])
+	assert.NotContains(t, deploy.Spec.Selector.MatchLabels, RuntimeLabel)
+	assert.NotContains(t, deploy.Labels, RuntimeLabel)
+
+	app.Spec.Container.Resources = &corev1.ResourceRequirements{Requests: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("128Mi")}}
+	resources := MainResources(app)
+	assert.Equal(t, "128Mi", resources.Requests.Memory().String())
+
+	app.Spec.Runtime = ""
+	app.Spec.Container.Resources = nil
+	assert.Empty(t, MainResources(app).Requests, "Expected no resources without spec.runtime")
+}
+
+// Test the Deployment of a HelidonApp being debugged
+func TestDeploymentDebug(t *testing.T) {
+	app := &verrazzanov1.HelidonApp{ObjectMeta: metav1.ObjectMeta{Name: "myapp", Namespace: "myns"}}
+	app.Spec.Name = "myapp"
+	app.Spec.Namespace = "myns"
+	app.Spec.Container.Image = "myimage"
+	app.Spec.Container.Env = []corev1.EnvVar{{Name: "JAVA_TOOL_OPTIONS", Value: "-Xmx256m"}}
+	app.Spec.Helidon = &verrazzanov1.HelidonSpec{}
+	replicas := int32(3)
+	app.Spec.Scaling.Replicas = &replicas
+	app.Spec.Debug = &verrazzanov1.DebugSpec{Enabled: true}
+
+	deploy := Deployment(app)
+	main := deploy.Spec.Template.Spec.Containers[0]
+	assert.Equal(t, int32(1), *deploy.Spec.Replicas)
+	assert.Equal(t, []corev1.ContainerPort{{ContainerPort: 8080}, {Name: "jdwp", ContainerPort: 5005, Protocol: corev1.ProtocolTCP}}, main.Ports)
+	assert.Equal(t, []corev1.EnvVar{{Name: "JAVA_TOOL_OPTIONS", Value: "-Xmx256m -agentlib:jdwp=transport=dt_socket,server=y,suspend=n,address=*:5005"}}, main.Env)
+	assert.Nil(t, main.LivenessProbe)
+	assert.NotNil(t, main.ReadinessProbe)
+	assert.Len(t, Service(app).Spec.Ports, 1)
+	assert.Equal(t, []corev1.EnvVar{{Name: "JAVA_TOOL_OPTIONS", Value: "-Xmx256m"}}, app.Spec.Container.Env, "Expected the spec to be unchanged")
+
+	app.Spec.Debug.Enabled = false
+	deploy = Deployment(app)
+	main = deploy.Spec.Template.Spec.Containers[0]
+	assert.Equal(t, int32(3), *deploy.Spec.Replicas)
+	assert.Len(t, main.Ports, 1)
+	assert.Equal(t, app.Spec.Container.Env, main.Env)
+	assert.NotNil(t, main.LivenessProbe)
+}
+
+// Test the Deployment of a HelidonApp connecting to a database with a wallet
+func TestDeploymentWallet(t *testing.T) {
+	app := &verrazzanov1.HelidonApp{ObjectMeta: metav1.ObjectMeta{Name: "myapp", Namespace: "myns"}}
+	app.Spec.Name = "myapp"
+	app.Spec.Namespace = "myns"
+	app.Spec.Container.Image = "myimage"
+	app.Spec.Container.Env = []corev1.EnvVar{{Name: "JAVA_TOOL_OPTIONS", Value: "-Xmx256m"}}
+	app.Spec.Database = &verrazzanov1.DatabaseSpec{Wallet: &verrazzanov1.WalletSpec{Secret: "atp-wallet"}}
+
+	deploy := Deployment(app)
+	main := deploy.Spec.Template.Spec.Containers[0]
+	assert.Equal(t, []corev1.EnvVar{
+		{Name: "JAVA_TOOL_OPTIONS", Value: "-Xmx256m -Doracle.net.tns_admin=/wallet " +
+			"-Doracle.net.wallet_location=(SOURCE=(METHOD=FILE)(METHOD_DATA=(DIRECTORY=/wallet)))"},
+		{Name: "TNS_ADMIN", Value: "/wallet"},
+	}, main.Env)
+	assert.Equal(t, []corev1.VolumeMount{{Name: "database-wallet", MountPath: "/wallet", ReadOnly: true}}, main.VolumeMounts)
+	volumes := deploy.Spec.Template.Spec.Volumes
+	if assert.Len(t, volumes, 1) {
+		assert.Equal(t, "atp-wallet", volumes[0].Secret.SecretName)
+	}
+
+	app.Spec.Container.Env = nil
+	app.Spec.Runtime = verrazzanov1.HelidonRuntimeNative
+	app.Spec.Database.Wallet.MountPath = "/opt/wallet"
+	main = Deployment(app).Spec.Template.Spec.Containers[0]
+	assert.Equal(t, []corev1.EnvVar{{Name: "TNS_ADMIN", Value: "/opt/wallet"}}, main.Env, "Expected no JVM options for native executables")
+	assert.Equal(t, "/opt/wallet", main.VolumeMounts[0].MountPath)
+}
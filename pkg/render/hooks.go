@@ -0,0 +1,96 @@
+// Copyright (c) 2020, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package render
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+
+	verrazzanov1 "github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// HookLabel is the label of the hook Jobs and their pods holding the name of the hook
+	HookLabel = "helidonapp.verrazzano.io/hook"
+	// HookRevisionAnnotation is the annotation of the hook Jobs holding the revision they run for
+	HookRevisionAnnotation = "helidonapp.verrazzano.io/revision"
+	// PreDeployHook is the hook of spec.hooks.preDeploy
+	PreDeployHook = "preDeploy"
+	// PostDeployHook is the hook of spec.hooks.postDeploy
+	PostDeployHook = "postDeploy"
+	// DefaultHookHistoryLimit is the default number of completed hook Jobs kept
+	DefaultHookHistoryLimit = 3
+)
+
+// HookRevision returns the revision of the image and of the hash of the configuration of the pods, which
+// identifies the Jobs of the hooks
+func HookRevision(image string, configHash string) string {
+	sum := sha256.Sum256([]byte(image + "\n" + configHash))
+	return hex.EncodeToString(sum[:])[:10]
+}
+
+// HookJobName returns the name of the Job of a hook for a revision. The name of the Helidon application is
+// shortened so the name fits in a label.
+func HookJobName(cr *verrazzanov1.HelidonApp, hook string, revision string) string {
+	suffix := "-" + strings.ToLower(hook) + "-" + revision
+	name := cr.Spec.Name
+	if len(name)+len(suffix) > 63 {
+		name = strings.TrimRight(name[:63-len(suffix)], "-.")
+	}
+	return name + suffix
+}
+
+// HookJob returns the Job of a hook for a revision. Its container gets the environment variables, volumes and
+// image pull secrets of the main container, without the debug agent.
+func HookJob(cr *verrazzanov1.HelidonApp, hook string, spec *verrazzanov1.HookSpec, revision string) *batchv1.Job {
+	app := cr.DeepCopy()
+	app.Spec.Debug = nil
+
+	image := spec.Image
+	if image == "" {
+		image = cr.Spec.Container.Image
+	}
+	backoffLimit := int32(0)
+	if spec.BackoffLimit != nil {
+		backoffLimit = *spec.BackoffLimit
+	}
+	labels := map[string]string{HookLabel: hook}
+
+	return &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        HookJobName(cr, hook, revision),
+			Namespace:   cr.Spec.Namespace,
+			Labels:      map[string]string{HookLabel: hook},
+			Annotations: map[string]string{HookRevisionAnnotation: revision},
+		},
+		Spec: batchv1.JobSpec{
+			BackoffLimit:          &backoffLimit,
+			ActiveDeadlineSeconds: spec.ActiveDeadlineSeconds,
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: labels,
+				},
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{{
+						Name:            strings.ToLower(hook),
+						Image:           image,
+						ImagePullPolicy: cr.Spec.Container.ImagePullPolicy,
+						Command:         spec.Command,
+						Args:            spec.Args,
+						Env:             append(MainEnv(app), spec.Env...),
+						VolumeMounts:    MainVolumeMounts(app),
+					}},
+					RestartPolicy:      corev1.RestartPolicyNever,
+					ServiceAccountName: cr.Spec.ServiceAccountName,
+					ImagePullSecrets:   cr.Spec.Container.ImagePullSecrets,
+					Volumes:            Volumes(app),
+				},
+			},
+		},
+	}
+}
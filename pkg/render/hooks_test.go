@@ -0,0 +1,72 @@
+// Copyright (c) 2020, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package render
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	verrazzanov1 "github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Test the Job of a hook runs with the configuration of the main container, without the debug agent
+func TestHookJob(t *testing.T) {
+	app := &verrazzanov1.HelidonApp{ObjectMeta: metav1.ObjectMeta{Name: "orders", Namespace: "apps"}}
+	app.Spec.Name = "orders"
+	app.Spec.Namespace = "orders-ns"
+	app.Spec.ServiceAccountName = "orders-sa"
+	app.Spec.Container.Image = "orders:2.0"
+	app.Spec.Container.Env = []corev1.EnvVar{{Name: "DB_USER", Value: "orders"}}
+	app.Spec.Database = &verrazzanov1.DatabaseSpec{Wallet: &verrazzanov1.WalletSpec{Secret: "atp-wallet"}}
+	app.Spec.Debug = &verrazzanov1.DebugSpec{Enabled: true}
+	hook := &verrazzanov1.HookSpec{Command: []string{"flyway", "migrate"}, Env: []corev1.EnvVar{{Name: "FLYWAY_LOCATIONS", Value: "db"}}}
+
+	job := HookJob(app, PreDeployHook, hook, "1a2b3c4d5e")
+	assert.Equal(t, "orders-predeploy-1a2b3c4d5e", job.Name)
+	assert.Equal(t, "orders-ns", job.Namespace)
+	assert.Equal(t, "preDeploy", job.Labels[HookLabel])
+	assert.Equal(t, "1a2b3c4d5e", job.Annotations[HookRevisionAnnotation])
+	assert.Equal(t, int32(0), *job.Spec.BackoffLimit)
+	pod := job.Spec.Template.Spec
+	assert.Equal(t, corev1.RestartPolicyNever, pod.RestartPolicy)
+	assert.Equal(t, "orders-sa", pod.ServiceAccountName)
+	assert.NotContains(t, job.Spec.Template.Labels, "app", "Expected the Job pods not to be selected by the Service")
+	container := pod.Containers[0]
+	assert.Equal(t, "orders:2.0", container.Image)
+	assert.Equal(t, []string{"flyway", "migrate"}, container.Command)
+	assert.Equal(t, "DB_USER", container.Env[0].Name)
+	assert.Equal(t, "FLYWAY_LOCATIONS", container.Env[len(container.Env)-1].Name)
+	for _, env := range container.Env {
+		if env.Name == "JAVA_TOOL_OPTIONS" {
+			assert.NotContains(t, env.Value, "jdwp")
+		}
+	}
+	assert.Equal(t, "database-wallet", container.VolumeMounts[0].Name)
+	assert.Equal(t, "atp-wallet", pod.Volumes[0].Secret.SecretName)
+
+	backoffLimit := int32(2)
+	hook.Image = "orders-migrations:2.0"
+	hook.BackoffLimit = &backoffLimit
+	job = HookJob(app, PreDeployHook, hook, "1a2b3c4d5e")
+	assert.Equal(t, "orders-migrations:2.0", job.Spec.Template.Spec.Containers[0].Image)
+	assert.Equal(t, int32(2), *job.Spec.BackoffLimit)
+}
+
+// Test the names of hook Jobs fit in a label and the revisions follow the image and configuration
+func TestHookJobName(t *testing.T) {
+	app := &verrazzanov1.HelidonApp{}
+	app.Spec.Name = strings.Repeat("a", 50) + "-b"
+	name := HookJobName(app, PreDeployHook, "1a2b3c4d5e")
+	assert.Len(t, name, 63)
+	assert.True(t, strings.HasSuffix(name, "a-predeploy-1a2b3c4d5e"))
+
+	revision := HookRevision("orders:1.0", "")
+	assert.Len(t, revision, 10)
+	assert.Equal(t, revision, HookRevision("orders:1.0", ""))
+	assert.NotEqual(t, revision, HookRevision("orders:2.0", ""))
+	assert.NotEqual(t, revision, HookRevision("orders:1.0", "abc"))
+}
@@ -0,0 +1,40 @@
+// Copyright (c) 2020, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package render
+
+import (
+	verrazzanov1 "github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1"
+	"github.com/verrazzano/verrazzano-helidon-app-operator/pkg/helidon"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// LoggingVolumeName is the name of the volume of the logging ConfigMap
+const LoggingVolumeName = "logging-config"
+
+// LoggingConfigMapName returns the name of the ConfigMap holding the logging configuration of an application
+func LoggingConfigMapName(name string) string {
+	return name + "-logging"
+}
+
+// LoggingConfigMap returns the ConfigMap holding the logging configuration generated from spec.logging, nil
+// when spec.logging is not set
+func LoggingConfigMap(cr *verrazzanov1.HelidonApp) *corev1.ConfigMap {
+	if cr.Spec.Logging == nil {
+		return nil
+	}
+	return &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      LoggingConfigMapName(cr.Spec.Name),
+			Namespace: cr.Spec.Namespace,
+			Labels:    map[string]string{"app": cr.Spec.Name},
+		},
+		Data: map[string]string{helidon.LoggingFile(cr): helidon.LoggingConfig(cr)},
+	}
+}
+
+// loggingFilePath returns the path of the logging configuration file in the main container
+func loggingFilePath(cr *verrazzanov1.HelidonApp) string {
+	return helidon.LoggingMountPath + "/" + helidon.LoggingFile(cr)
+}
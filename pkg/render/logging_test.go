@@ -0,0 +1,48 @@
+// Copyright (c) 2020, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package render
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	verrazzanov1 "github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Test the logging ConfigMap and how the main container uses it
+func TestManifestsLogging(t *testing.T) {
+	app := &verrazzanov1.HelidonApp{ObjectMeta: metav1.ObjectMeta{Name: "myapp", Namespace: "myns"}}
+	app.Spec.Name = "myapp"
+	app.Spec.Namespace = "myns"
+	app.Spec.Container.Image = "myimage"
+	app.Spec.Logging = &verrazzanov1.LoggingSpec{Level: verrazzanov1.LogLevelDebug}
+	app.Spec.Debug = &verrazzanov1.DebugSpec{Enabled: true}
+
+	objects := Manifests(app)
+	configMap := objects[1].(*corev1.ConfigMap)
+	assert.Equal(t, "myapp-logging", configMap.Name)
+	assert.Equal(t, "myns", configMap.Namespace)
+	assert.Equal(t, "myapp", configMap.Labels[OwnerNameLabel])
+	assert.Contains(t, configMap.Data["logging.properties"], ".level = FINE\n")
+
+	main := objects[2].(*appsv1.Deployment).Spec.Template.Spec.Containers[0]
+	assert.Equal(t, []corev1.EnvVar{{Name: "JAVA_TOOL_OPTIONS",
+		Value: "-Djava.util.logging.config.file=/logging/logging.properties -agentlib:jdwp=transport=dt_socket,server=y,suspend=n,address=*:5005"}}, main.Env)
+	assert.Equal(t, []corev1.VolumeMount{{Name: "logging-config", MountPath: "/logging", ReadOnly: true}}, main.VolumeMounts)
+	volumes := Volumes(app)
+	if assert.Len(t, volumes, 1) {
+		assert.Equal(t, "myapp-logging", volumes[0].ConfigMap.Name)
+	}
+
+	app.Spec.Debug = nil
+	app.Spec.Logging.Framework = verrazzanov1.LoggingFrameworkLog4j2
+	assert.Equal(t, []corev1.EnvVar{{Name: "LOG4J_CONFIGURATION_FILE", Value: "/logging/log4j2.properties"}}, MainEnv(app))
+
+	app.Spec.Logging = nil
+	assert.Nil(t, LoggingConfigMap(app))
+	assert.Len(t, Manifests(app), 3)
+}
@@ -0,0 +1,80 @@
+// Copyright (c) 2020, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package render
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+
+	verrazzanov1 "github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// OwnerNamespaceLabel is the label holding the namespace of the HelidonApp that owns a resource
+	OwnerNamespaceLabel = "helidonapp.verrazzano.io/owner-namespace"
+	// OwnerNameLabel is the label holding the name of the HelidonApp that owns a resource
+	OwnerNameLabel = "helidonapp.verrazzano.io/owner-name"
+	// OwnerNameAnnotation is the annotation holding the full name of the HelidonApp that owns a resource, when
+	// the name is too long for the OwnerNameLabel
+	OwnerNameAnnotation = "helidonapp.verrazzano.io/owner-name"
+
+	// maxLabelValueLength is the maximum length of a label value
+	maxLabelValueLength = 63
+	// labelHashLength is the length of the hash ending a shortened label value
+	labelHashLength = 10
+)
+
+// OwnerLabels returns the labels identifying resources owned by the given HelidonApp
+func OwnerLabels(cr *verrazzanov1.HelidonApp) map[string]string {
+	return map[string]string{
+		OwnerNamespaceLabel: cr.Namespace,
+		OwnerNameLabel:      ownerNameLabelValue(cr.Name),
+	}
+}
+
+// ownerNameLabelValue returns the name when it fits in a label value, otherwise the start of the name followed
+// by a hash of the full name
+func ownerNameLabelValue(name string) string {
+	if len(name) <= maxLabelValueLength {
+		return name
+	}
+	sum := sha256.Sum256([]byte(name))
+	return name[:maxLabelValueLength-labelHashLength-1] + "-" + hex.EncodeToString(sum[:])[:labelHashLength]
+}
+
+// OwnerName returns the name of the HelidonApp owning obj from its owner annotation or label, or an empty string
+func OwnerName(obj metav1.Object) string {
+	if name, ok := obj.GetAnnotations()[OwnerNameAnnotation]; ok {
+		return name
+	}
+	return obj.GetLabels()[OwnerNameLabel]
+}
+
+// SetOwnerLabels labels obj as owned by the HelidonApp. The full name of a HelidonApp too long for a label
+// value is kept in the owner annotation.
+func SetOwnerLabels(cr *verrazzanov1.HelidonApp, obj metav1.Object) {
+	labels := obj.GetLabels()
+	if labels == nil {
+		labels = make(map[string]string)
+	}
+	for k, v := range OwnerLabels(cr) {
+		labels[k] = v
+	}
+	obj.SetLabels(labels)
+
+	annotations := obj.GetAnnotations()
+	if labels[OwnerNameLabel] == cr.Name {
+		if _, ok := annotations[OwnerNameAnnotation]; ok {
+			delete(annotations, OwnerNameAnnotation)
+			obj.SetAnnotations(annotations)
+		}
+		return
+	}
+	if annotations == nil {
+		annotations = make(map[string]string)
+	}
+	annotations[OwnerNameAnnotation] = cr.Name
+	obj.SetAnnotations(annotations)
+}
@@ -0,0 +1,218 @@
+// Copyright (c) 2020, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+// Package render generates the Kubernetes resources of a HelidonApp. It is used by the operator and by
+// the render command, so manifests can be generated without contacting a cluster.
+package render
+
+import (
+	"fmt"
+	"time"
+
+	verrazzanov1 "github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1"
+	"github.com/verrazzano/verrazzano-helidon-app-operator/pkg/helidon"
+	"github.com/verrazzano/verrazzano-helidon-app-operator/pkg/schedule"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+// PrometheusAnnotationKeys are the annotations used by Prometheus to discover the Helidon application metrics
+var PrometheusAnnotationKeys = []string{"prometheus.io/scrape", "prometheus.io/port", "prometheus.io/path"}
+
+// RestartedAtAnnotation on the pod template holds spec.restartAt, so that changing it restarts the pods
+const RestartedAtAnnotation = "helidonapp.verrazzano.io/restartedAt"
+
+// Manifests returns all of the resources generated for a HelidonApp, in the order they are created, labeled
+// as owned by the HelidonApp. Owner references are set by the operator since they need the HelidonApp UID.
+func Manifests(cr *verrazzanov1.HelidonApp) []runtime.Object {
+	namespace := Namespace(cr)
+	namespace.TypeMeta = metav1.TypeMeta{APIVersion: "v1", Kind: "Namespace"}
+	objects := []runtime.Object{namespace}
+
+	if cr.Spec.ServiceAccountName != "" {
+		serviceAccount := ServiceAccount(cr)
+		serviceAccount.TypeMeta = metav1.TypeMeta{APIVersion: "v1", Kind: "ServiceAccount"}
+		SetOwnerLabels(cr, serviceAccount)
+		objects = append(objects, serviceAccount)
+	}
+
+	if configMap := LoggingConfigMap(cr); configMap != nil {
+		configMap.TypeMeta = metav1.TypeMeta{APIVersion: "v1", Kind: "ConfigMap"}
+		SetOwnerLabels(cr, configMap)
+		objects = append(objects, configMap)
+	}
+
+	deployment := Deployment(cr)
+	deployment.TypeMeta = metav1.TypeMeta{APIVersion: "apps/v1", Kind: "Deployment"}
+	service := Service(cr)
+	service.TypeMeta = metav1.TypeMeta{APIVersion: "v1", Kind: "Service"}
+	SetOwnerLabels(cr, deployment)
+	SetRuntimeLabel(cr, deployment)
+	SetOwnerLabels(cr, service)
+	return append(objects, deployment, service)
+}
+
+// Deployment returns a deployment for creating/updating a Helidon application deployment
+func Deployment(cr *verrazzanov1.HelidonApp) *appsv1.Deployment {
+	labels := make(map[string]string)
+	labels["app"] = cr.Spec.Name
+
+	livenessProbe, readinessProbe := Probes(cr)
+	containers := []corev1.Container{
+		{
+			Name:            cr.Spec.Name,
+			Image:           cr.Spec.Container.Image,
+			ImagePullPolicy: cr.Spec.Container.ImagePullPolicy,
+			Ports:           MainPorts(cr),
+			Env:             MainEnv(cr),
+			Resources:       MainResources(cr),
+			VolumeMounts:    MainVolumeMounts(cr),
+			LivenessProbe:   livenessProbe,
+			ReadinessProbe:  readinessProbe,
+		},
+	}
+
+	// Include any additional containers specified in the CR
+	for _, container := range cr.Spec.Containers {
+		containers = append(containers, container)
+	}
+
+	// The operator overrides the replicas with the active spec.schedule entry
+	replicas := schedule.Replicas(cr, nil)
+
+	templateAnnotations := PrometheusAnnotations(cr)
+	if cr.Spec.RestartAt != nil {
+		templateAnnotations[RestartedAtAnnotation] = RestartedAt(cr)
+	}
+
+	return &appsv1.Deployment{
+
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        cr.Spec.Name,
+			Namespace:   cr.Spec.Namespace,
+			Labels:      labels,
+			Annotations: PrometheusAnnotations(cr),
+		},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: &replicas,
+			Selector: &metav1.LabelSelector{
+				MatchLabels: labels,
+			},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels:      labels,
+					Annotations: templateAnnotations,
+				},
+				Spec: corev1.PodSpec{
+					InitContainers:     InitContainers(cr),
+					Containers:         containers,
+					ServiceAccountName: cr.Spec.ServiceAccountName,
+					ImagePullSecrets:   cr.Spec.Container.ImagePullSecrets,
+					Volumes:            Volumes(cr),
+				},
+			},
+		},
+	}
+}
+
+// RestartedAt returns the value of the restartedAt annotation of the pod template, empty if spec.restartAt is
+// not set
+func RestartedAt(cr *verrazzanov1.HelidonApp) string {
+	if cr.Spec.RestartAt == nil {
+		return ""
+	}
+	return cr.Spec.RestartAt.UTC().Format(time.RFC3339)
+}
+
+// Service returns a service for creating a Helidon application service
+func Service(cr *verrazzanov1.HelidonApp) *corev1.Service {
+	labels := make(map[string]string)
+	labels["app"] = cr.Spec.Name
+
+	port, targetPort := Ports(cr)
+
+	return &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      cr.Spec.Name,
+			Namespace: cr.Spec.Namespace,
+			Labels:    labels,
+		},
+		Spec: corev1.ServiceSpec{
+			Type:     corev1.ServiceTypeClusterIP,
+			Selector: labels,
+			Ports: []corev1.ServicePort{
+				{
+					Name: "http",
+					Port: port,
+					TargetPort: intstr.IntOrString{
+						Type:   intstr.Int,
+						IntVal: targetPort,
+					},
+				},
+			},
+		},
+	}
+}
+
+// Ports returns the port and targetPort values
+func Ports(cr *verrazzanov1.HelidonApp) (int32, int32) {
+	// Default port value is 8080
+	var port int32 = 8080
+	if cr.Spec.Service.Port != 0 {
+		port = cr.Spec.Service.Port
+	}
+	// Default target port value is value of port
+	var targetPort = port
+	if cr.Spec.Service.TargetPort != 0 {
+		targetPort = cr.Spec.Service.TargetPort
+	}
+
+	return port, targetPort
+}
+
+// PrometheusAnnotations returns the Prometheus annotations for scraping the Helidon application metrics, if enabled
+func PrometheusAnnotations(cr *verrazzanov1.HelidonApp) map[string]string {
+	annotations := make(map[string]string)
+	metrics := cr.Spec.Observability.Metrics
+	if metrics.Enabled != nil && !*metrics.Enabled {
+		return annotations
+	}
+	_, targetPort := Ports(cr)
+	// Default metrics path is the one of the Helidon version
+	path := helidon.For(cr).MetricsPath
+	if metrics.Path != "" {
+		path = metrics.Path
+	}
+	annotations["prometheus.io/scrape"] = "true"
+	annotations["prometheus.io/port"] = fmt.Sprint(targetPort)
+	annotations["prometheus.io/path"] = path
+	return annotations
+}
+
+// Namespace returns a namespace resource that may need to be created
+func Namespace(cr *verrazzanov1.HelidonApp) *corev1.Namespace {
+	labels := make(map[string]string)
+	labels["istio-injection"] = "enabled"
+
+	namespace := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   cr.Spec.Namespace,
+			Labels: labels,
+		},
+	}
+
+	return namespace
+}
+
+// ServiceAccount returns a serviceaccount resource that may need to be created
+func ServiceAccount(cr *verrazzanov1.HelidonApp) *corev1.ServiceAccount {
+	return &corev1.ServiceAccount{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      cr.Spec.ServiceAccountName,
+			Namespace: cr.Spec.Namespace,
+		},
+	}
+}
@@ -0,0 +1,89 @@
+// Copyright (c) 2020, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package render
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	verrazzanov1 "github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const testApps = `
+apiVersion: verrazzano.io/v1beta1
+kind: HelidonApp
+metadata:
+  name: hello
+  namespace: default
+spec:
+  name: hello
+  namespace: hello-ns
+  image: hello:1.0
+  port: 8010
+---
+apiVersion: verrazzano.io/v1
+kind: HelidonApp
+metadata:
+  name: other
+  namespace: default
+spec:
+  name: other
+  namespace: other-ns
+  serviceAccountName: other-sa
+  container:
+    image: other:1.0
+`
+
+// Test reading v1 and v1beta1 HelidonApps
+func TestReadHelidonApps(t *testing.T) {
+	apps, err := ReadHelidonApps(strings.NewReader(testApps))
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(apps))
+	assert.Equal(t, "hello:1.0", apps[0].Spec.Container.Image, "Expected v1beta1 HelidonApp to be converted")
+	assert.Equal(t, int32(8010), apps[0].Spec.Service.Port)
+	assert.Equal(t, "other:1.0", apps[1].Spec.Container.Image)
+
+	_, err = ReadHelidonApps(strings.NewReader("apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: cm\n"))
+	assert.Error(t, err, "Expected error for a resource that isn't a HelidonApp")
+}
+
+// Test the resources generated for a HelidonApp
+func TestManifests(t *testing.T) {
+	app := &verrazzanov1.HelidonApp{ObjectMeta: metav1.ObjectMeta{Name: "myapp", Namespace: "crns"}}
+	app.Spec.Name = "myapp"
+	app.Spec.Namespace = "targetns"
+	app.Spec.Container.Image = "myimage"
+
+	objects := Manifests(app)
+	assert.Equal(t, 3, len(objects), "Expected no ServiceAccount when none is named")
+	assert.IsType(t, &corev1.Namespace{}, objects[0])
+	deploy := objects[1].(*appsv1.Deployment)
+	assert.Equal(t, "Deployment", deploy.Kind)
+	assert.Equal(t, "crns", deploy.Labels[OwnerNamespaceLabel])
+	assert.Equal(t, "myapp", deploy.Labels[OwnerNameLabel])
+	assert.Equal(t, "myimage", deploy.Spec.Template.Spec.Containers[0].Image)
+	assert.IsType(t, &corev1.Service{}, objects[2])
+
+	app.Spec.ServiceAccountName = "mysa"
+	objects = Manifests(app)
+	assert.Equal(t, 4, len(objects))
+	assert.Equal(t, "mysa", objects[1].(*corev1.ServiceAccount).Name)
+}
+
+// Test writing the manifests as YAML documents
+func TestWriteManifests(t *testing.T) {
+	apps, err := ReadHelidonApps(strings.NewReader(testApps))
+	assert.NoError(t, err)
+	out := &bytes.Buffer{}
+	assert.NoError(t, WriteManifests(out, Manifests(apps[0])))
+	assert.Equal(t, 3, strings.Count(out.String(), "---\n"))
+	assert.Contains(t, out.String(), "kind: Deployment")
+	assert.Contains(t, out.String(), "image: hello:1.0")
+	assert.Contains(t, out.String(), "port: 8010")
+}
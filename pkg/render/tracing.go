@@ -0,0 +1,68 @@
+// Copyright (c) 2020, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package render
+
+import (
+	"net/url"
+
+	verrazzanov1 "github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1"
+	"github.com/verrazzano/verrazzano-helidon-app-operator/pkg/helidon"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// TracingEnv returns the environment variables configuring spec.tracing: the Helidon tracing configuration,
+// followed by the OTEL_* variables of the OpenTelemetry SDK for the opentelemetry provider
+func TracingEnv(cr *verrazzanov1.HelidonApp) []corev1.EnvVar {
+	if !helidon.TracingEnabled(cr) {
+		return nil
+	}
+	var env []corev1.EnvVar
+	config := func(key string, value string) {
+		env = append(env, corev1.EnvVar{Name: helidon.ConfigEnv(cr, key), Value: value})
+	}
+
+	provider := helidon.TracingProvider(cr)
+	service := helidon.TracingServiceName(cr)
+	endpoint := helidon.TracingEndpoint(cr)
+	config("tracing.enabled", "true")
+	config("tracing.service", service)
+	if u, err := url.Parse(endpoint); err == nil && u.Hostname() != "" {
+		port := u.Port()
+		if port == "" && u.Scheme == "https" {
+			port = "443"
+		} else if port == "" {
+			port = "80"
+		}
+		config("tracing.protocol", u.Scheme)
+		config("tracing.host", u.Hostname())
+		config("tracing.port", port)
+		if u.Path != "" {
+			config("tracing.path", u.Path)
+		}
+	}
+	samplingRate := cr.Spec.Tracing.SamplingRate
+	if _, err := helidon.SamplingRate(cr); err != nil {
+		samplingRate = ""
+	}
+	if provider == verrazzanov1.TracingProviderJaeger && samplingRate != "" {
+		config("tracing.sampler-type", "probabilistic")
+		config("tracing.sampler-param", samplingRate)
+	}
+
+	if provider != verrazzanov1.TracingProviderOpenTelemetry {
+		return env
+	}
+	env = append(env,
+		corev1.EnvVar{Name: "OTEL_SERVICE_NAME", Value: service},
+		corev1.EnvVar{Name: "OTEL_TRACES_EXPORTER", Value: "otlp"})
+	if endpoint != "" {
+		env = append(env, corev1.EnvVar{Name: "OTEL_EXPORTER_OTLP_ENDPOINT", Value: endpoint})
+	}
+	if samplingRate != "" {
+		env = append(env,
+			corev1.EnvVar{Name: "OTEL_TRACES_SAMPLER", Value: "parentbased_traceidratio"},
+			corev1.EnvVar{Name: "OTEL_TRACES_SAMPLER_ARG", Value: samplingRate})
+	}
+	return env
+}
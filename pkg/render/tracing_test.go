@@ -0,0 +1,63 @@
+// Copyright (c) 2020, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package render
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	verrazzanov1 "github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1"
+	"github.com/verrazzano/verrazzano-helidon-app-operator/pkg/helidon"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// Test the environment variables of the opentelemetry provider with the operator wide endpoint
+func TestTracingEnvOpenTelemetry(t *testing.T) {
+	helidon.DefaultTracingEndpoint = "http://otel-collector.monitoring:4318"
+	defer func() { helidon.DefaultTracingEndpoint = "" }()
+	app := &verrazzanov1.HelidonApp{}
+	app.Spec.Name = "myapp"
+	app.Spec.Tracing = &verrazzanov1.TracingSpec{Enabled: true, SamplingRate: "0.25"}
+
+	assert.Equal(t, []corev1.EnvVar{
+		{Name: "TRACING_ENABLED", Value: "true"},
+		{Name: "TRACING_SERVICE", Value: "myapp"},
+		{Name: "TRACING_PROTOCOL", Value: "http"},
+		{Name: "TRACING_HOST", Value: "otel-collector.monitoring"},
+		{Name: "TRACING_PORT", Value: "4318"},
+		{Name: "OTEL_SERVICE_NAME", Value: "myapp"},
+		{Name: "OTEL_TRACES_EXPORTER", Value: "otlp"},
+		{Name: "OTEL_EXPORTER_OTLP_ENDPOINT", Value: "http://otel-collector.monitoring:4318"},
+		{Name: "OTEL_TRACES_SAMPLER", Value: "parentbased_traceidratio"},
+		{Name: "OTEL_TRACES_SAMPLER_ARG", Value: "0.25"},
+	}, TracingEnv(app))
+
+	app.Spec.Tracing.Enabled = false
+	assert.Empty(t, TracingEnv(app))
+}
+
+// Test the environment variables of the jaeger provider, and that spec.container.env overrides them
+func TestTracingEnvJaeger(t *testing.T) {
+	app := &verrazzanov1.HelidonApp{}
+	app.Spec.Name = "myapp"
+	app.Spec.Helidon = &verrazzanov1.HelidonSpec{Flavor: verrazzanov1.HelidonFlavorMP, Version: "2.4.0"}
+	app.Spec.Container.Env = []corev1.EnvVar{{Name: "TRACING_SERVICE", Value: "orders"}}
+	app.Spec.Tracing = &verrazzanov1.TracingSpec{
+		Enabled:      true,
+		Provider:     verrazzanov1.TracingProviderJaeger,
+		Endpoint:     "https://jaeger.example.com/api/traces",
+		SamplingRate: "0.1",
+	}
+
+	assert.Equal(t, []corev1.EnvVar{
+		{Name: "TRACING_SERVICE", Value: "orders"},
+		{Name: "TRACING_ENABLED", Value: "true"},
+		{Name: "TRACING_PROTOCOL", Value: "https"},
+		{Name: "TRACING_HOST", Value: "jaeger.example.com"},
+		{Name: "TRACING_PORT", Value: "443"},
+		{Name: "TRACING_PATH", Value: "/api/traces"},
+		{Name: "TRACING_SAMPLER_TYPE", Value: "probabilistic"},
+		{Name: "TRACING_SAMPLER_PARAM", Value: "0.1"},
+	}, MainEnv(app))
+}
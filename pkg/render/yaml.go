@@ -0,0 +1,78 @@
+// Copyright (c) 2020, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package render
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+
+	verrazzanov1 "github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1"
+	"github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1beta1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
+	"sigs.k8s.io/yaml"
+)
+
+var scheme = runtime.NewScheme()
+
+func init() {
+	addToSchemes := []func(*runtime.Scheme) error{verrazzanov1.AddToScheme, v1beta1.AddToScheme}
+	for _, addToScheme := range addToSchemes {
+		if err := addToScheme(scheme); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// ReadHelidonApps reads the HelidonApps from a stream of YAML or JSON documents. Both the v1 and v1beta1
+// versions are accepted, v1beta1 HelidonApps are converted to v1.
+func ReadHelidonApps(r io.Reader) ([]*verrazzanov1.HelidonApp, error) {
+	decoder := serializer.NewCodecFactory(scheme).UniversalDeserializer()
+	reader := utilyaml.NewYAMLReader(bufio.NewReader(r))
+	var apps []*verrazzanov1.HelidonApp
+	for {
+		doc, err := reader.Read()
+		if err == io.EOF {
+			return apps, nil
+		} else if err != nil {
+			return nil, err
+		}
+		if len(bytes.TrimSpace(doc)) == 0 {
+			continue
+		}
+		obj, gvk, err := decoder.Decode(doc, nil, nil)
+		if err != nil {
+			return nil, err
+		}
+		switch app := obj.(type) {
+		case *verrazzanov1.HelidonApp:
+			apps = append(apps, app)
+		case *v1beta1.HelidonApp:
+			hub := &verrazzanov1.HelidonApp{}
+			if err := app.ConvertTo(hub); err != nil {
+				return nil, err
+			}
+			apps = append(apps, hub)
+		default:
+			return nil, fmt.Errorf("unexpected %s, only HelidonApp can be rendered", gvk.Kind)
+		}
+	}
+}
+
+// WriteManifests writes the objects as a stream of YAML documents
+func WriteManifests(w io.Writer, objects []runtime.Object) error {
+	for _, obj := range objects {
+		data, err := yaml.Marshal(obj)
+		if err != nil {
+			return err
+		}
+		if _, err := fmt.Fprintf(w, "---\n%s", data); err != nil {
+			return err
+		}
+	}
+	return nil
+}
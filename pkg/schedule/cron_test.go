@@ -0,0 +1,46 @@
+// Copyright (c) 2020, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package schedule
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// Test finding the next activation of cron expressions
+func TestCronNext(t *testing.T) {
+	// Monday
+	from := time.Date(2020, 6, 1, 12, 30, 15, 0, time.UTC)
+	tests := []struct {
+		expression string
+		next       time.Time
+	}{
+		{"* * * * *", time.Date(2020, 6, 1, 12, 31, 0, 0, time.UTC)},
+		{"0 20 * * *", time.Date(2020, 6, 1, 20, 0, 0, 0, time.UTC)},
+		{"0 7 * * *", time.Date(2020, 6, 2, 7, 0, 0, 0, time.UTC)},
+		{"*/15 * * * *", time.Date(2020, 6, 1, 12, 45, 0, 0, time.UTC)},
+		{"0 8 * * sat,SUN", time.Date(2020, 6, 6, 8, 0, 0, 0, time.UTC)},
+		{"0 8 * * 7", time.Date(2020, 6, 7, 8, 0, 0, 0, time.UTC)},
+		{"0 0 1 jan-mar *", time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{"0 0 15 * 3", time.Date(2020, 6, 3, 0, 0, 0, 0, time.UTC)},
+		{"0 0 30 2 *", time.Time{}},
+	}
+	for _, test := range tests {
+		c, err := ParseCron(test.expression)
+		if assert.NoError(t, err, test.expression) {
+			assert.Equal(t, test.next, c.Next(from), test.expression)
+		}
+	}
+}
+
+// Test that invalid cron expressions are rejected
+func TestParseCronInvalid(t *testing.T) {
+	for _, expression := range []string{"", "* * * *", "60 * * * *", "* 24 * * *", "* * 0 * *", "* * * 13 *", "* * * * 8",
+		"5-1 * * * *", "*/0 * * * *", "* * * foo *"} {
+		_, err := ParseCron(expression)
+		assert.Error(t, err, expression)
+	}
+}
@@ -0,0 +1,98 @@
+// Copyright (c) 2020, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+// Package schedule evaluates the spec.schedule windows of HelidonApps, which override the replicas of the
+// application between a start and an end given as cron expressions.
+package schedule
+
+import (
+	"fmt"
+	"time"
+
+	verrazzanov1 "github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1"
+	"github.com/verrazzano/verrazzano-helidon-app-operator/pkg/helidon"
+)
+
+// window is a parsed schedule entry
+type window struct {
+	entry *verrazzanov1.ScheduleEntry
+	start *Cron
+	end   *Cron
+	loc   *time.Location
+}
+
+// Validate checks the cron expressions and time zones of the schedule entries
+func Validate(entries []verrazzanov1.ScheduleEntry) error {
+	_, err := parse(entries)
+	return err
+}
+
+// Active returns the first schedule entry whose window is active at the given time, or nil, and the time the
+// next window starts or ends, or the zero time if there are no entries.
+// A window is active when its end comes before its next start.
+func Active(entries []verrazzanov1.ScheduleEntry, now time.Time) (*verrazzanov1.ScheduleEntry, time.Time, error) {
+	windows, err := parse(entries)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+	var active *verrazzanov1.ScheduleEntry
+	var next time.Time
+	for _, w := range windows {
+		local := now.In(w.loc)
+		nextStart := w.start.Next(local)
+		nextEnd := w.end.Next(local)
+		if active == nil && !nextEnd.IsZero() && (nextStart.IsZero() || nextEnd.Before(nextStart)) {
+			active = w.entry
+		}
+		for _, boundary := range []time.Time{nextStart, nextEnd} {
+			if !boundary.IsZero() && (next.IsZero() || boundary.Before(next)) {
+				next = boundary
+			}
+		}
+	}
+	if !next.IsZero() {
+		next = next.UTC()
+	}
+	return active, next, nil
+}
+
+// Replicas returns the replicas of the HelidonApp considering spec.suspend, the active schedule entry and
+// spec.debug, which runs a single replica
+func Replicas(cr *verrazzanov1.HelidonApp, active *verrazzanov1.ScheduleEntry) int32 {
+	switch {
+	case cr.Spec.Suspend:
+		return 0
+	case active != nil && active.Suspend:
+		return 0
+	case helidon.DebugEnabled(cr):
+		return 1
+	case active != nil && active.Replicas != nil:
+		return *active.Replicas
+	case cr.Spec.Scaling.Replicas != nil:
+		return *cr.Spec.Scaling.Replicas
+	}
+	return 1
+}
+
+// parse parses the cron expressions and loads the time zones of the entries
+func parse(entries []verrazzanov1.ScheduleEntry) ([]window, error) {
+	var windows []window
+	for i := range entries {
+		entry := &entries[i]
+		w := window{entry: entry, loc: time.UTC}
+		var err error
+		if entry.TimeZone != "" {
+			if w.loc, err = time.LoadLocation(entry.TimeZone); err != nil {
+				return nil, fmt.Errorf("schedule entry %s: invalid time zone %s", entry.Name, entry.TimeZone)
+			}
+		}
+		if w.start, err = ParseCron(entry.Start); err != nil {
+			return nil, fmt.Errorf("schedule entry %s: start: %s", entry.Name, err.Error())
+		}
+		if w.end, err = ParseCron(entry.End); err != nil {
+			return nil, fmt.Errorf("schedule entry %s: end: %s", entry.Name, err.Error())
+		}
+		windows = append(windows, w)
+	}
+	return windows, nil
+}
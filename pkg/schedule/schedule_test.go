@@ -0,0 +1,58 @@
+// Copyright (c) 2020, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package schedule
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	verrazzanov1 "github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1"
+)
+
+// Test finding the active window and the next boundary, in the time zone of the entries
+func TestActive(t *testing.T) {
+	two := int32(2)
+	entries := []verrazzanov1.ScheduleEntry{
+		{Name: "nights", Start: "0 20 * * *", End: "0 7 * * *", TimeZone: "America/New_York", Suspend: true},
+		{Name: "reduced", Start: "0 12 * * *", End: "0 14 * * *", Replicas: &two},
+	}
+
+	// 13:00 UTC is 09:00 in New York
+	active, next, err := Active(entries, time.Date(2020, 6, 1, 13, 0, 0, 0, time.UTC))
+	assert.NoError(t, err)
+	if assert.NotNil(t, active) {
+		assert.Equal(t, "reduced", active.Name)
+	}
+	assert.Equal(t, time.Date(2020, 6, 1, 14, 0, 0, 0, time.UTC), next)
+
+	// 03:00 UTC is 23:00 in New York
+	active, next, err = Active(entries, time.Date(2020, 6, 2, 3, 0, 0, 0, time.UTC))
+	assert.NoError(t, err)
+	if assert.NotNil(t, active) {
+		assert.Equal(t, "nights", active.Name)
+	}
+	assert.Equal(t, time.Date(2020, 6, 2, 11, 0, 0, 0, time.UTC), next)
+
+	active, _, err = Active(entries, time.Date(2020, 6, 1, 15, 0, 0, 0, time.UTC))
+	assert.NoError(t, err)
+	assert.Nil(t, active)
+
+	_, _, err = Active([]verrazzanov1.ScheduleEntry{{Name: "bad", Start: "* * * * *", End: "* * * * *", TimeZone: "Nowhere/City"}}, time.Now())
+	assert.Error(t, err)
+}
+
+// Test the replicas from spec.suspend, the active entry and spec.scaling
+func TestReplicas(t *testing.T) {
+	three := int32(3)
+	two := int32(2)
+	cr := &verrazzanov1.HelidonApp{}
+	assert.Equal(t, int32(1), Replicas(cr, nil))
+	cr.Spec.Scaling.Replicas = &three
+	assert.Equal(t, int32(3), Replicas(cr, nil))
+	assert.Equal(t, int32(2), Replicas(cr, &verrazzanov1.ScheduleEntry{Replicas: &two}))
+	assert.Equal(t, int32(0), Replicas(cr, &verrazzanov1.ScheduleEntry{Suspend: true}))
+	cr.Spec.Suspend = true
+	assert.Equal(t, int32(0), Replicas(cr, &verrazzanov1.ScheduleEntry{Replicas: &two}))
+}
@@ -0,0 +1,104 @@
+// Copyright (c) 2020, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+// Package tenancy restricts where HelidonApps deploy and which ServiceAccounts they run as, so that creating
+// a HelidonApp in a namespace doesn't give access to other namespaces through the operator.
+package tenancy
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	verrazzanov1 "github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// AllServiceAccounts in the allowed ServiceAccounts allows any ServiceAccount
+const AllServiceAccounts = "*"
+
+// Policy is the tenancy policy of HelidonApps:
+//   - spec.namespace must be the namespace of the HelidonApp, unless a HelidonAppNamespaceGrant in the target
+//     namespace lists the namespace of the HelidonApp as a source namespace
+//   - spec.serviceAccountName must be empty, in AllowedServiceAccounts or in the ServiceAccounts of the grant
+type Policy struct {
+	// AllowedServiceAccounts are the names of the ServiceAccounts HelidonApps may run as in any namespace
+	AllowedServiceAccounts []string
+}
+
+// ParseServiceAccounts parses a comma separated list of ServiceAccount names
+func ParseServiceAccounts(value string) []string {
+	var names []string
+	for _, name := range strings.Split(value, ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// Check returns an error describing the violation if the HelidonApp doesn't comply with the policy.
+// Grants are read with the reader.
+func (p *Policy) Check(ctx context.Context, reader client.Reader, cr *verrazzanov1.HelidonApp) error {
+	var grant *verrazzanov1.HelidonAppNamespaceGrant
+	if cr.Spec.Namespace != cr.Namespace {
+		var err error
+		grant, err = FindGrant(ctx, reader, cr.Spec.Namespace, cr.Namespace)
+		if err != nil {
+			return err
+		}
+		if grant == nil {
+			return &ViolationError{fmt.Sprintf("spec.namespace %s is not the namespace of the HelidonApp and no HelidonAppNamespaceGrant in %s allows namespace %s",
+				cr.Spec.Namespace, cr.Spec.Namespace, cr.Namespace)}
+		}
+	}
+
+	if cr.Spec.ServiceAccountName == "" || contains(p.AllowedServiceAccounts, AllServiceAccounts) ||
+		contains(p.AllowedServiceAccounts, cr.Spec.ServiceAccountName) {
+		return nil
+	}
+	if grant != nil && contains(grant.Spec.ServiceAccountNames, cr.Spec.ServiceAccountName) {
+		return nil
+	}
+	return &ViolationError{fmt.Sprintf("spec.serviceAccountName %s is not an allowed ServiceAccount", cr.Spec.ServiceAccountName)}
+}
+
+// FindGrant returns the grant in the target namespace allowing HelidonApps from the source namespace, or nil
+// if there is none
+func FindGrant(ctx context.Context, reader client.Reader, targetNamespace string, sourceNamespace string) (*verrazzanov1.HelidonAppNamespaceGrant, error) {
+	grants := &verrazzanov1.HelidonAppNamespaceGrantList{}
+	if err := reader.List(ctx, grants, client.InNamespace(targetNamespace)); err != nil {
+		return nil, err
+	}
+	for i := range grants.Items {
+		if contains(grants.Items[i].Spec.SourceNamespaces, sourceNamespace) {
+			return &grants.Items[i], nil
+		}
+	}
+	return nil, nil
+}
+
+// ViolationError is returned when a HelidonApp doesn't comply with the policy, as opposed to a failure
+// to check the policy
+type ViolationError struct {
+	message string
+}
+
+func (e *ViolationError) Error() string {
+	return e.message
+}
+
+// IsViolation checks if the error is a policy violation
+func IsViolation(err error) bool {
+	_, ok := err.(*ViolationError)
+	return ok
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
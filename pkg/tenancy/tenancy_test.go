@@ -0,0 +1,58 @@
+// Copyright (c) 2020, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package tenancy
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	verrazzanov1 "github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestParseServiceAccounts(t *testing.T) {
+	assert.Nil(t, ParseServiceAccounts(""))
+	assert.Equal(t, []string{"sa1", "sa2"}, ParseServiceAccounts(" sa1, ,sa2 "))
+}
+
+func TestCheck(t *testing.T) {
+	s := runtime.NewScheme()
+	assert.NoError(t, verrazzanov1.AddToScheme(s))
+	grant := &verrazzanov1.HelidonAppNamespaceGrant{
+		ObjectMeta: metav1.ObjectMeta{Name: "grant", Namespace: "targetns"},
+		Spec: verrazzanov1.HelidonAppNamespaceGrantSpec{
+			SourceNamespaces:    []string{"crns"},
+			ServiceAccountNames: []string{"grantsa"},
+		},
+	}
+	reader := fake.NewFakeClientWithScheme(s, grant)
+	app := &verrazzanov1.HelidonApp{ObjectMeta: metav1.ObjectMeta{Name: "myapp", Namespace: "crns"}}
+	app.Spec.Namespace = "crns"
+	policy := &Policy{AllowedServiceAccounts: []string{"mysa"}}
+
+	assert.NoError(t, policy.Check(context.TODO(), reader, app))
+	app.Spec.ServiceAccountName = "mysa"
+	assert.NoError(t, policy.Check(context.TODO(), reader, app))
+	app.Spec.ServiceAccountName = "grantsa"
+	err := policy.Check(context.TODO(), reader, app)
+	assert.True(t, IsViolation(err), "Expected grant ServiceAccount to only be allowed in the granted namespace")
+
+	app.Spec.Namespace = "targetns"
+	assert.NoError(t, policy.Check(context.TODO(), reader, app))
+	app.Spec.Namespace = "otherns"
+	app.Spec.ServiceAccountName = ""
+	err = policy.Check(context.TODO(), reader, app)
+	assert.True(t, IsViolation(err), "Expected namespace without a grant to be rejected")
+
+	policy.AllowedServiceAccounts = []string{AllServiceAccounts}
+	app.Spec.Namespace = "crns"
+	app.Spec.ServiceAccountName = "anysa"
+	assert.NoError(t, policy.Check(context.TODO(), reader, app))
+
+	assert.False(t, IsViolation(errors.New("other error")))
+}
@@ -0,0 +1,23 @@
+// Copyright (c) 2020, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package webhook
+
+import (
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/conversion"
+)
+
+// ConversionPath is the path the CRD conversion webhook is served at
+const ConversionPath = "/convert"
+
+func init() {
+	// AddToManagerFuncs is a list of functions to create webhooks and add them to a manager.
+	AddToManagerFuncs = append(AddToManagerFuncs, addConversion)
+}
+
+// addConversion registers the webhook converting HelidonApps between the v1beta1 and v1 versions
+func addConversion(mgr manager.Manager) error {
+	mgr.GetWebhookServer().Register(ConversionPath, &conversion.Webhook{})
+	return nil
+}
@@ -0,0 +1,109 @@
+// Copyright (c) 2020, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package webhook
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"go.uber.org/zap"
+
+	verrazzanov1 "github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1"
+	"github.com/verrazzano/verrazzano-helidon-app-operator/pkg/bindings"
+	"github.com/verrazzano/verrazzano-helidon-app-operator/pkg/database"
+	"github.com/verrazzano/verrazzano-helidon-app-operator/pkg/dependencies"
+	"github.com/verrazzano/verrazzano-helidon-app-operator/pkg/helidon"
+	"github.com/verrazzano/verrazzano-helidon-app-operator/pkg/imagepolicy"
+	"github.com/verrazzano/verrazzano-helidon-app-operator/pkg/schedule"
+	"github.com/verrazzano/verrazzano-helidon-app-operator/pkg/tenancy"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// ValidationPath is the path the HelidonApp validating admission webhook is served at
+const ValidationPath = "/validate-helidonapp"
+
+// Options holds the operator wide settings for the webhooks
+type Options struct {
+	// Tenancy restricts the target namespace and the ServiceAccount of HelidonApps
+	Tenancy tenancy.Policy
+}
+
+// WebhookOptions are the settings used when the webhooks are added to the Manager.
+// They must be set before AddToManager is called.
+var WebhookOptions = Options{}
+
+func init() {
+	AddToManagerFuncs = append(AddToManagerFuncs, addValidation)
+}
+
+// addValidation registers the webhook validating HelidonApps when they are created or updated
+func addValidation(mgr manager.Manager) error {
+	mgr.GetWebhookServer().Register(ValidationPath, &admission.Webhook{
+		Handler: &helidonAppValidator{reader: mgr.GetAPIReader(), tenancy: WebhookOptions.Tenancy},
+	})
+	return nil
+}
+
+// helidonAppValidator rejects HelidonApps with invalid Helidon settings or schedule, or that violate the
+// tenancy policy or the image policies
+type helidonAppValidator struct {
+	reader  client.Reader
+	tenancy tenancy.Policy
+	decoder *admission.Decoder
+}
+
+// Handle validates the HelidonApp of an admission request
+func (v *helidonAppValidator) Handle(ctx context.Context, req admission.Request) admission.Response {
+	app := &verrazzanov1.HelidonApp{}
+	if err := v.decoder.Decode(req, app); err != nil {
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+	// Let HelidonApps being deleted go, so the finalizer can be removed
+	if app.DeletionTimestamp != nil {
+		return admission.Allowed("")
+	}
+
+	if err := helidon.Validate(app); err != nil {
+		return admission.Denied("HelidonApp has invalid Helidon settings: " + err.Error())
+	}
+	if err := schedule.Validate(app.Spec.Schedule); err != nil {
+		return admission.Denied("HelidonApp has an invalid schedule: " + err.Error())
+	}
+	if err := bindings.Validate(app); err != nil {
+		return admission.Denied("HelidonApp has invalid bindings: " + err.Error())
+	}
+	if err := database.Validate(app); err != nil {
+		return admission.Denied("HelidonApp has invalid database settings: " + err.Error())
+	}
+	if err := dependencies.Validate(app); err != nil {
+		return admission.Denied("HelidonApp has invalid dependencies: " + err.Error())
+	}
+
+	if err := v.tenancy.Check(ctx, v.reader, app); err != nil {
+		if tenancy.IsViolation(err) {
+			return admission.Denied("HelidonApp violates the tenancy policy: " + err.Error())
+		}
+		zap.S().Errorf("Failed to check the tenancy policy, Name: %s Namespace: %s, Error: %s", app.Name, app.Namespace, err.Error())
+		return admission.Errored(http.StatusInternalServerError, err)
+	}
+
+	violations, err := imagepolicy.Check(ctx, v.reader, app)
+	if err != nil {
+		zap.S().Errorf("Failed to check the image policies, Name: %s Namespace: %s, Error: %s", app.Name, app.Namespace, err.Error())
+		return admission.Errored(http.StatusInternalServerError, err)
+	}
+	if len(violations) > 0 {
+		return admission.Denied("HelidonApp violates the image policy: " + strings.Join(violations, "; "))
+	}
+	return admission.Allowed("")
+}
+
+// InjectDecoder injects the decoder of admission requests
+func (v *helidonAppValidator) InjectDecoder(d *admission.Decoder) error {
+	v.decoder = d
+	return nil
+}
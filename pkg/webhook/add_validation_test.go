@@ -0,0 +1,164 @@
+// Copyright (c) 2020, Oracle and/or its affiliates.
+// Licensed under the Universal Permissive License v 1.0 as shown at https://oss.oracle.com/licenses/upl.
+
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	verrazzanov1 "github.com/verrazzano/verrazzano-helidon-app-operator/pkg/apis/verrazzano/v1"
+	"github.com/verrazzano/verrazzano-helidon-app-operator/pkg/tenancy"
+	admissionv1beta1 "k8s.io/api/admission/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// Test that HelidonApps violating the tenancy policy are denied
+func TestHelidonAppValidator(t *testing.T) {
+	s := runtime.NewScheme()
+	assert.NoError(t, verrazzanov1.AddToScheme(s))
+	decoder, err := admission.NewDecoder(s)
+	assert.NoError(t, err)
+	grant := &verrazzanov1.HelidonAppNamespaceGrant{
+		ObjectMeta: metav1.ObjectMeta{Name: "grant", Namespace: "granted"},
+		Spec:       verrazzanov1.HelidonAppNamespaceGrantSpec{SourceNamespaces: []string{"crns"}},
+	}
+	v := &helidonAppValidator{
+		reader:  fake.NewFakeClientWithScheme(s, grant),
+		tenancy: tenancy.Policy{AllowedServiceAccounts: []string{"allowed"}},
+	}
+	assert.NoError(t, v.InjectDecoder(decoder))
+
+	tests := []struct {
+		targetNamespace string
+		serviceAccount  string
+		allowed         bool
+	}{
+		{"crns", "", true},
+		{"crns", "allowed", true},
+		{"crns", "other", false},
+		{"kube-system", "", false},
+		{"granted", "", true},
+	}
+	for _, test := range tests {
+		app := &verrazzanov1.HelidonApp{ObjectMeta: metav1.ObjectMeta{Name: "myapp", Namespace: "crns"}}
+		app.Spec.Name = "myapp"
+		app.Spec.Namespace = test.targetNamespace
+		app.Spec.ServiceAccountName = test.serviceAccount
+		raw, err := json.Marshal(app)
+		assert.NoError(t, err)
+
+		resp := v.Handle(context.TODO(), admission.Request{AdmissionRequest: admissionv1beta1.AdmissionRequest{
+			Operation: admissionv1beta1.Create,
+			Object:    runtime.RawExtension{Raw: raw},
+		}})
+		assert.Equal(t, test.allowed, resp.Allowed, "namespace %s, ServiceAccount %s", test.targetNamespace, test.serviceAccount)
+	}
+}
+
+// Test that HelidonApps violating an image policy are denied
+func TestHelidonAppValidatorImagePolicy(t *testing.T) {
+	s := runtime.NewScheme()
+	assert.NoError(t, scheme.AddToScheme(s))
+	assert.NoError(t, verrazzanov1.AddToScheme(s))
+	decoder, err := admission.NewDecoder(s)
+	assert.NoError(t, err)
+	policy := &verrazzanov1.HelidonAppImagePolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "registries"},
+		Spec: verrazzanov1.HelidonAppImagePolicySpec{
+			AllowedRegistries: []string{"container-registry.oracle.com"},
+			ForbiddenTags:     []string{"latest"},
+		},
+	}
+	v := &helidonAppValidator{reader: fake.NewFakeClientWithScheme(s, policy)}
+	assert.NoError(t, v.InjectDecoder(decoder))
+
+	tests := []struct {
+		image   string
+		allowed bool
+	}{
+		{"container-registry.oracle.com/app:1.0", true},
+		{"container-registry.oracle.com/app", false},
+		{"docker.io/app:1.0", false},
+	}
+	for _, test := range tests {
+		app := &verrazzanov1.HelidonApp{ObjectMeta: metav1.ObjectMeta{Name: "myapp", Namespace: "crns"}}
+		app.Spec.Name = "myapp"
+		app.Spec.Namespace = "crns"
+		app.Spec.Container.Image = test.image
+		raw, err := json.Marshal(app)
+		assert.NoError(t, err)
+
+		resp := v.Handle(context.TODO(), admission.Request{AdmissionRequest: admissionv1beta1.AdmissionRequest{
+			Operation: admissionv1beta1.Create,
+			Object:    runtime.RawExtension{Raw: raw},
+		}})
+		assert.Equal(t, test.allowed, resp.Allowed, "image %s", test.image)
+	}
+}
+
+// Test that HelidonApps with invalid Helidon settings or schedule are denied
+func TestHelidonAppValidatorInvalidSpec(t *testing.T) {
+	s := runtime.NewScheme()
+	assert.NoError(t, verrazzanov1.AddToScheme(s))
+	decoder, err := admission.NewDecoder(s)
+	assert.NoError(t, err)
+	v := &helidonAppValidator{reader: fake.NewFakeClientWithScheme(s)}
+	assert.NoError(t, v.InjectDecoder(decoder))
+
+	tests := []struct {
+		name    string
+		modify  func(spec *verrazzanov1.HelidonAppSpec)
+		message string
+	}{
+		{"schedule", func(spec *verrazzanov1.HelidonAppSpec) {
+			spec.Schedule = []verrazzanov1.ScheduleEntry{{Name: "nights", Start: "0 20 * * *", End: "0 7 * *"}}
+		}, "schedule entry nights"},
+		{"version", func(spec *verrazzanov1.HelidonAppSpec) {
+			spec.Helidon = &verrazzanov1.HelidonSpec{Version: "latest"}
+		}, "invalid Helidon version latest"},
+		{"profile", func(spec *verrazzanov1.HelidonAppSpec) {
+			spec.Helidon = &verrazzanov1.HelidonSpec{Flavor: verrazzanov1.HelidonFlavorMP, Version: "2.4.0", ConfigProfile: "dev"}
+		}, "not supported by Helidon MP 2"},
+		{"debug", func(spec *verrazzanov1.HelidonAppSpec) {
+			spec.Runtime = verrazzanov1.HelidonRuntimeNative
+			spec.Debug = &verrazzanov1.DebugSpec{Enabled: true}
+		}, "remote debugging is not supported by the native runtime"},
+		{"tracing", func(spec *verrazzanov1.HelidonAppSpec) {
+			spec.Tracing = &verrazzanov1.TracingSpec{Enabled: true, Endpoint: "collector:4318"}
+		}, "invalid tracing endpoint collector:4318"},
+		{"logging", func(spec *verrazzanov1.HelidonAppSpec) {
+			spec.Logging = &verrazzanov1.LoggingSpec{Levels: map[string]verrazzanov1.LogLevel{"io.helidon=FINE": verrazzanov1.LogLevelDebug}}
+		}, "invalid logger name"},
+		{"bindings", func(spec *verrazzanov1.HelidonAppSpec) {
+			spec.Bindings = []verrazzanov1.ServiceBinding{{Name: "db", Secret: "db", Type: "mongodb", Env: true}}
+		}, "binding db can't map type \"mongodb\""},
+		{"database", func(spec *verrazzanov1.HelidonAppSpec) {
+			spec.Database = &verrazzanov1.DatabaseSpec{Wallet: &verrazzanov1.WalletSpec{Secret: "wallet", MountPath: "wallet"}}
+		}, "invalid wallet mount path wallet"},
+		{"dependencies", func(spec *verrazzanov1.HelidonAppSpec) {
+			spec.DependsOn = []verrazzanov1.Dependency{{Name: "orders"}, {Name: "orders", Namespace: "crns"}}
+		}, "duplicate dependency crns/orders"},
+	}
+	for _, test := range tests {
+		app := &verrazzanov1.HelidonApp{ObjectMeta: metav1.ObjectMeta{Name: "myapp", Namespace: "crns"}}
+		app.Spec.Name = "myapp"
+		app.Spec.Namespace = "crns"
+		test.modify(&app.Spec)
+		raw, err := json.Marshal(app)
+		assert.NoError(t, err)
+
+		resp := v.Handle(context.TODO(), admission.Request{AdmissionRequest: admissionv1beta1.AdmissionRequest{
+			Operation: admissionv1beta1.Create,
+			Object:    runtime.RawExtension{Raw: raw},
+		}})
+		assert.False(t, resp.Allowed, test.name)
+		assert.Contains(t, string(resp.Result.Reason), test.message, test.name)
+	}
+}